@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+)
+
+// Interface is the minimal typed client for the SlowdownProfile CRD,
+// hand-written rather than client-gen'd since it is the only resource in
+// this group. It follows the same List/Watch/Get shape client-gen would
+// produce so that it plugs directly into a cache.ListWatch.
+type Interface interface {
+	SlowdownProfiles(namespace string) SlowdownProfileInterface
+}
+
+// SlowdownProfileInterface is the typed client for SlowdownProfile in a
+// single namespace.
+type SlowdownProfileInterface interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*SlowdownProfile, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*SlowdownProfileList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// Clientset is the concrete Interface implementation, backed by a
+// rest.Interface configured for the acti.ckatsak.io/v1alpha1 group.
+type Clientset struct {
+	restClient rest.Interface
+}
+
+// NewForConfig builds a Clientset from a rest.Config, registering the
+// SlowdownProfile types with the client's negotiated serializer.
+func NewForConfig(c *rest.Config) (*Clientset, error) {
+	config := *c
+	config.GroupVersion = &SchemeGroupVersion
+	config.APIPath = "/apis"
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	config.NegotiatedSerializer = serializer.NewCodecFactory(scheme).WithoutConversion()
+
+	restClient, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &Clientset{restClient: restClient}, nil
+}
+
+func (c *Clientset) SlowdownProfiles(namespace string) SlowdownProfileInterface {
+	return &slowdownProfileClient{restClient: c.restClient, ns: namespace}
+}
+
+type slowdownProfileClient struct {
+	restClient rest.Interface
+	ns         string
+}
+
+func (c *slowdownProfileClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*SlowdownProfile, error) {
+	result := &SlowdownProfile{}
+	err := c.restClient.Get().
+		Namespace(c.ns).
+		Resource("slowdownprofiles").
+		Name(name).
+		VersionedParams(&opts, metav1.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *slowdownProfileClient) List(ctx context.Context, opts metav1.ListOptions) (*SlowdownProfileList, error) {
+	result := &SlowdownProfileList{}
+	err := c.restClient.Get().
+		Namespace(c.ns).
+		Resource("slowdownprofiles").
+		VersionedParams(&opts, metav1.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *slowdownProfileClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.restClient.Get().
+		Namespace(c.ns).
+		Resource("slowdownprofiles").
+		VersionedParams(&opts, metav1.ParameterCodec).
+		Watch(ctx)
+}
@@ -0,0 +1,64 @@
+// Package v1alpha1 contains the SlowdownProfile custom resource definition,
+// group acti.ckatsak.io/v1alpha1, which the acti/crd implementation of
+// acti.InterferenceModel watches to build its interference matrix.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SlowdownProfile describes the slowdown incurred by colocating Pods of
+// different application categories on a particular class of hardware,
+// selected via NodeSelector. Multiple SlowdownProfiles may exist in a
+// cluster to let different hardware SKUs carry different matrices; when a
+// node matches more than one profile (e.g. a SKU-specific profile and a
+// default, nil-NodeSelector one), the profile with a non-nil NodeSelector
+// takes precedence, and only a (attackerCategory, occupantCategory) pair
+// missing from every matching specific profile falls back to a matching
+// default profile's Entries.
+type SlowdownProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SlowdownProfileSpec `json:"spec"`
+}
+
+// SlowdownProfileSpec is the spec of a SlowdownProfile.
+type SlowdownProfileSpec struct {
+	// Entries is the list of pairwise slowdowns that make up the
+	// interference matrix. A (attackerCategory, occupantCategory) pair
+	// missing from this list has no known slowdown; see
+	// acti/crd.ErrNoSlowdownData.
+	Entries []SlowdownEntry `json:"entries"`
+
+	// NodeSelector restricts this profile to nodes matching the given
+	// labels. A nil NodeSelector applies to every node not matched by a
+	// more specific profile.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// SlowdownEntry is a single pairwise entry of a SlowdownProfileSpec.
+type SlowdownEntry struct {
+	// AttackerCategory is the application category of the Pod causing
+	// the interference.
+	AttackerCategory string `json:"attackerCategory"`
+	// OccupantCategory is the application category of the Pod
+	// experiencing the slowdown.
+	OccupantCategory string `json:"occupantCategory"`
+	// Slowdown is the multiplicative slowdown OccupantCategory incurs
+	// when colocated with AttackerCategory (e.g. 1.5 means 50% slower).
+	Slowdown float64 `json:"slowdown"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SlowdownProfileList is a list of SlowdownProfile.
+type SlowdownProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []SlowdownProfile `json:"items"`
+}
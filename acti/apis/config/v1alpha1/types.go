@@ -0,0 +1,99 @@
+// Package v1alpha1 contains the KubeSchedulerConfiguration args types
+// accepted by ActiPlugin, as referenced from a KubeSchedulerConfiguration's
+// pluginConfig[].args.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ActiConfig holds the configuration for ActiPlugin, including the
+// descheduler loop that evicts colocations whose observed interference
+// exceeds a per-category budget.
+type ActiConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CategorySlowdownBudget maps an application category (as used by the
+	// configured acti.InterferenceModel) to the maximum aggregate
+	// slowdown a Pod of that category may tolerate from its co-tenants
+	// before it becomes an eviction candidate. Categories absent from
+	// this map are never evicted by the descheduler loop.
+	CategorySlowdownBudget map[string]float64 `json:"categorySlowdownBudget"`
+
+	// MaxEvictionsPerMinute caps the rate at which the descheduler loop
+	// evicts Pods, across the whole cluster. Zero disables the loop
+	// entirely.
+	MaxEvictionsPerMinute int32 `json:"maxEvictionsPerMinute"`
+
+	// DryRun, when true, makes the descheduler loop compute and log
+	// eviction decisions without ever calling the Eviction subresource.
+	DryRun bool `json:"dryRun"`
+
+	// ScoreWeights configures how the Score extension point combines the
+	// dimensions of a MultiObjectiveModel's ObjectiveVector. A zero value
+	// falls back to ScoreWeights' own defaults (all weight on Slowdown).
+	// +optional
+	ScoreWeights ScoreWeights `json:"scoreWeights,omitempty"`
+
+	// ModelType selects the acti.InterferenceModel implementation
+	// ActiPlugin and the descheduler loop construct. Defaults to
+	// ModelTypeHardcoded.
+	// +optional
+	ModelType ModelType `json:"modelType,omitempty"`
+
+	// PMU configures the runtime PMU-based model (acti/pmu), used when
+	// ModelType is ModelTypePMU.
+	// +optional
+	PMU *PMUModelConfig `json:"pmu,omitempty"`
+
+	// Power, when set, wraps whichever MultiObjectiveModel ModelType
+	// selects with acti/power.AnnotationAdapter, so the Score extension
+	// point's PowerDeltaWatts dimension is driven by node annotations
+	// published by an out-of-tree power-monitoring agent.
+	// +optional
+	Power *PowerModelConfig `json:"power,omitempty"`
+}
+
+// ModelType selects an acti.InterferenceModel implementation.
+type ModelType string
+
+const (
+	// ModelTypeHardcoded selects hardcoded.HardcodedSlowDowns, the
+	// compile-time matrix. This is the default.
+	ModelTypeHardcoded ModelType = "Hardcoded"
+	// ModelTypePMU selects pmu.Model, which derives slowdown estimates at
+	// runtime from hardware performance counters.
+	ModelTypePMU ModelType = "PMU"
+	// ModelTypeCRD selects crd.Model, which derives slowdown estimates
+	// from SlowdownProfile custom resources, hot-reloaded at runtime.
+	ModelTypeCRD ModelType = "CRD"
+)
+
+// PMUModelConfig configures pmu.Model; see pmu.Coefficients for field docs.
+type PMUModelConfig struct {
+	Alpha          float64 `json:"alpha"`
+	Beta           float64 `json:"beta"`
+	NodeMBWCapMBps float64 `json:"nodeMBWCapMBps"`
+}
+
+// PowerModelConfig configures power.AnnotationAdapter; see
+// power.CategoryWatts for field docs.
+type PowerModelConfig struct {
+	CategoryWatts map[string]float64 `json:"categoryWatts"`
+}
+
+// ScoreWeights assigns a weight to each dimension of an ObjectiveVector,
+// used to combine them into a single Score. Weights need not sum to 1; they
+// are applied after each dimension has already been normalized to [0, 1]
+// across the candidate node set.
+type ScoreWeights struct {
+	// WSlow weighs the (min-max normalized) predicted slowdown.
+	WSlow float64 `json:"wSlow"`
+	// WPower weighs the (min-max normalized) predicted power delta.
+	WPower float64 `json:"wPower"`
+	// WMem weighs the (min-max normalized) predicted memory-bandwidth
+	// contention.
+	WMem float64 `json:"wMem"`
+	// WLLC weighs the (min-max normalized) predicted LLC pressure.
+	WLLC float64 `json:"wLLC"`
+}
@@ -0,0 +1,18 @@
+package crd
+
+import "fmt"
+
+// LookupError is returned by Model.Attack when no SlowdownProfile entry
+// covers the given (attackerCategory, occupantCategory) pair on the given
+// node, so the plugin can mark the Pod Unschedulable with a clear reason
+// instead of silently guessing.
+type LookupError struct {
+	AttackerCategory string
+	OccupantCategory string
+	Node             string
+}
+
+func (e *LookupError) Error() string {
+	return fmt.Sprintf("no SlowdownProfile entry covers attacker category %q against occupant category %q on node %q",
+		e.AttackerCategory, e.OccupantCategory, e.Node)
+}
@@ -0,0 +1,179 @@
+// Package crd contains an implementation of acti.InterferenceModel backed by
+// the namespaced SlowdownProfile custom resource (group acti.ckatsak.io/v1alpha1,
+// see acti/apis/acti/v1alpha1), replacing the compile-time matrix in
+// hardcoded. An informer watches SlowdownProfile objects and swaps the
+// in-memory matrix atomically, so Attack stays lock-free on the hot path.
+package crd
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+)
+
+const toInt64Multiplier = 100.
+
+// slowDownMatrix mirrors hardcoded's matrix shape, keyed by free-form
+// category strings rather than a closed enum, since SlowdownProfile entries
+// are user-authored.
+type slowDownMatrix map[string]map[string]float64
+
+// compiledProfile is a SlowdownProfile's NodeSelector pre-parsed into a
+// labels.Selector, paired with its flattened matrix.
+type compiledProfile struct {
+	name     string
+	selector labels.Selector // nil selector matches every node
+	matrix   slowDownMatrix
+}
+
+// Model is an implementation of acti.InterferenceModel backed by
+// SlowdownProfile custom resources.
+type Model struct {
+	actiLabelKey string
+	nodeLister   corelisters.NodeLister
+
+	profiles atomic.Pointer[[]compiledProfile]
+}
+
+// New returns a new Model with the given label key (the one that is used by
+// ActiPlugin to track its applications) and NodeLister (used to evaluate
+// each SlowdownProfile's NodeSelector against the occupant's node). Call Run
+// to start watching SlowdownProfile objects before using Attack.
+func New(actiLabelKey string, nodeLister corelisters.NodeLister) *Model {
+	m := &Model{actiLabelKey: actiLabelKey, nodeLister: nodeLister}
+	empty := []compiledProfile{}
+	m.profiles.Store(&empty)
+	return m
+}
+
+// Run starts an informer over SlowdownProfile objects (across all
+// namespaces) using client, and keeps m's in-memory matrix up to date until
+// ctx is cancelled. It blocks until the informer's cache has synced once.
+func (m *Model) Run(ctx context.Context, client activ1alpha1.Interface) error {
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.SlowdownProfiles(metav1.NamespaceAll).List(ctx, opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.SlowdownProfiles(metav1.NamespaceAll).Watch(ctx, opts)
+			},
+		},
+		&activ1alpha1.SlowdownProfile{},
+		0,
+		cache.Indexers{},
+	)
+
+	rebuildFromStore := func() {
+		items := informer.GetStore().List()
+		profiles := make([]*activ1alpha1.SlowdownProfile, 0, len(items))
+		for _, item := range items {
+			if p, ok := item.(*activ1alpha1.SlowdownProfile); ok {
+				profiles = append(profiles, p)
+			}
+		}
+		m.rebuild(profiles)
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { rebuildFromStore() },
+		UpdateFunc: func(interface{}, interface{}) { rebuildFromStore() },
+		DeleteFunc: func(interface{}) { rebuildFromStore() },
+	})
+
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return fmt.Errorf("crd: failed to sync SlowdownProfile informer cache")
+	}
+	return nil
+}
+
+// rebuild recompiles m.profiles from the full, current set of
+// SlowdownProfile objects, then atomically swaps it in.
+func (m *Model) rebuild(profiles []*activ1alpha1.SlowdownProfile) {
+	compiled := make([]compiledProfile, 0, len(profiles))
+	for _, p := range profiles {
+		var selector labels.Selector
+		if p.Spec.NodeSelector != nil {
+			s, err := metav1.LabelSelectorAsSelector(p.Spec.NodeSelector)
+			if err != nil {
+				klog.ErrorS(err, "crd: failed to parse NodeSelector, ignoring SlowdownProfile", "profile", p.Name)
+				continue
+			}
+			selector = s
+		}
+
+		matrix := make(slowDownMatrix)
+		for _, e := range p.Spec.Entries {
+			if matrix[e.AttackerCategory] == nil {
+				matrix[e.AttackerCategory] = make(map[string]float64)
+			}
+			matrix[e.AttackerCategory][e.OccupantCategory] = e.Slowdown
+		}
+
+		compiled = append(compiled, compiledProfile{name: p.Name, selector: selector, matrix: matrix})
+	}
+	m.profiles.Store(&compiled)
+}
+
+// Attack implements acti.InterferenceModel; see the documentation there for
+// more information. It returns a *LookupError when no SlowdownProfile
+// applicable to occupant's node covers the (attacker, occupant) category
+// pair, so the plugin can mark the Pod Unschedulable with a clear reason.
+func (m *Model) Attack(attacker, occupant *corev1.Pod) (float64, error) {
+	attackerCat := attacker.Labels[m.actiLabelKey]
+	occupantCat := occupant.Labels[m.actiLabelKey]
+
+	node := occupant.Spec.NodeName
+	if node == "" {
+		return -1, fmt.Errorf("crd: occupant Pod %s/%s is not yet bound to a node", occupant.Namespace, occupant.Name)
+	}
+	nodeObj, err := m.nodeLister.Get(node)
+	if err != nil {
+		return -1, fmt.Errorf("crd: failed to look up node %q: %w", node, err)
+	}
+
+	// A node can be matched by more than one profile at once: a default
+	// (nil NodeSelector) profile plus a more specific, SKU-targeted one.
+	// Prefer specific profiles' coverage over the default's, and only
+	// give up once no matching profile, specific or default, covers the
+	// (attacker, occupant) pair.
+	profiles := *m.profiles.Load()
+	for _, p := range profiles {
+		if p.selector == nil || !p.selector.Matches(labels.Set(nodeObj.Labels)) {
+			continue
+		}
+		if inner, ok := p.matrix[attackerCat]; ok {
+			if slowdown, ok := inner[occupantCat]; ok {
+				return slowdown, nil
+			}
+		}
+	}
+	for _, p := range profiles {
+		if p.selector != nil {
+			continue
+		}
+		if inner, ok := p.matrix[attackerCat]; ok {
+			if slowdown, ok := inner[occupantCat]; ok {
+				return slowdown, nil
+			}
+		}
+	}
+	return -1, &LookupError{AttackerCategory: attackerCat, OccupantCategory: occupantCat, Node: node}
+}
+
+// ToInt64Multiplier implements acti.InterferenceModel; see the documentation
+// there for more information.
+func (_ *Model) ToInt64Multiplier() float64 {
+	return toInt64Multiplier
+}
@@ -0,0 +1,224 @@
+package crd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+)
+
+const testLabelKey = "acti.ckatsak.io/category"
+
+func newTestModel(t *testing.T, nodes ...*corev1.Node) *Model {
+	t.Helper()
+
+	client := fake.NewSimpleClientset()
+	for _, n := range nodes {
+		if _, err := client.CoreV1().Nodes().Create(context.Background(), n, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("failed to create test node: %v", err)
+		}
+	}
+	factory := informers.NewSharedInformerFactory(client, 0)
+	nodeLister := factory.Core().V1().Nodes().Lister()
+	factory.Start(nil)
+	factory.WaitForCacheSync(nil)
+
+	return New(testLabelKey, nodeLister)
+}
+
+func testPod(name, category, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{testLabelKey: category}},
+		Spec:       corev1.PodSpec{NodeName: node},
+	}
+}
+
+func TestModelAttackUsesCurrentProfile(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	m := newTestModel(t, node)
+
+	m.rebuild([]*activ1alpha1.SlowdownProfile{{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: activ1alpha1.SlowdownProfileSpec{
+			Entries: []activ1alpha1.SlowdownEntry{
+				{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 1.5},
+			},
+		},
+	}})
+
+	attacker := testPod("attacker", "catA", "node1")
+	occupant := testPod("occupant", "catB", "node1")
+
+	got, err := m.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("Attack returned unexpected error: %v", err)
+	}
+	if got != 1.5 {
+		t.Errorf("Attack() = %f, want 1.5", got)
+	}
+}
+
+func TestModelAttackPicksUpHotReload(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	m := newTestModel(t, node)
+
+	m.rebuild([]*activ1alpha1.SlowdownProfile{{
+		ObjectMeta: metav1.ObjectMeta{Name: "v1"},
+		Spec: activ1alpha1.SlowdownProfileSpec{
+			Entries: []activ1alpha1.SlowdownEntry{
+				{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 1.5},
+			},
+		},
+	}})
+
+	attacker := testPod("attacker", "catA", "node1")
+	occupant := testPod("occupant", "catB", "node1")
+
+	before, err := m.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("Attack returned unexpected error before reload: %v", err)
+	}
+
+	// Simulate the informer observing an updated CR within one scheduling
+	// cycle: the matrix should swap atomically and Attack should reflect
+	// the new value immediately, with no restart required.
+	m.rebuild([]*activ1alpha1.SlowdownProfile{{
+		ObjectMeta: metav1.ObjectMeta{Name: "v1"},
+		Spec: activ1alpha1.SlowdownProfileSpec{
+			Entries: []activ1alpha1.SlowdownEntry{
+				{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 3.0},
+			},
+		},
+	}})
+
+	after, err := m.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("Attack returned unexpected error after reload: %v", err)
+	}
+	if after == before {
+		t.Fatalf("Attack() after hot reload = %f, want a different value than before (%f)", after, before)
+	}
+	if after != 3.0 {
+		t.Errorf("Attack() after hot reload = %f, want 3.0", after)
+	}
+}
+
+func TestModelAttackReturnsLookupErrorOnMiss(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	m := newTestModel(t, node)
+	m.rebuild([]*activ1alpha1.SlowdownProfile{{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       activ1alpha1.SlowdownProfileSpec{Entries: nil},
+	}})
+
+	attacker := testPod("attacker", "catA", "node1")
+	occupant := testPod("occupant", "catB", "node1")
+
+	_, err := m.Attack(attacker, occupant)
+	var lookupErr *LookupError
+	if !errors.As(err, &lookupErr) {
+		t.Fatalf("Attack() error = %v, want a *LookupError", err)
+	}
+}
+
+func TestModelAttackRespectsNodeSelector(t *testing.T) {
+	nodeA := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "nodeA", Labels: map[string]string{"sku": "gold"}}}
+	nodeB := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "nodeB", Labels: map[string]string{"sku": "silver"}}}
+	m := newTestModel(t, nodeA, nodeB)
+
+	m.rebuild([]*activ1alpha1.SlowdownProfile{{
+		ObjectMeta: metav1.ObjectMeta{Name: "gold-profile"},
+		Spec: activ1alpha1.SlowdownProfileSpec{
+			NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"sku": "gold"}},
+			Entries: []activ1alpha1.SlowdownEntry{
+				{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 2.0},
+			},
+		},
+	}})
+
+	occupantOnGold := testPod("occupant", "catB", "nodeA")
+	attacker := testPod("attacker", "catA", "nodeA")
+	if got, err := m.Attack(attacker, occupantOnGold); err != nil || got != 2.0 {
+		t.Fatalf("Attack() on gold node = (%f, %v), want (2.0, nil)", got, err)
+	}
+
+	occupantOnSilver := testPod("occupant", "catB", "nodeB")
+	attackerOnSilver := testPod("attacker", "catA", "nodeB")
+	if _, err := m.Attack(attackerOnSilver, occupantOnSilver); err == nil {
+		t.Fatal("Attack() on silver node expected an error (no profile matches), got nil")
+	}
+}
+
+func TestModelAttackPrefersSpecificProfileOverDefault(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "nodeA", Labels: map[string]string{"sku": "gold"}}}
+	m := newTestModel(t, node)
+
+	// Both profiles match nodeA at once: "default" via its nil
+	// NodeSelector, "gold-override" via its sku=gold selector. The
+	// gold-specific entry should win.
+	m.rebuild([]*activ1alpha1.SlowdownProfile{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: activ1alpha1.SlowdownProfileSpec{
+				Entries: []activ1alpha1.SlowdownEntry{
+					{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 1.5},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "gold-override"},
+			Spec: activ1alpha1.SlowdownProfileSpec{
+				NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"sku": "gold"}},
+				Entries: []activ1alpha1.SlowdownEntry{
+					{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 4.0},
+				},
+			},
+		},
+	})
+
+	attacker := testPod("attacker", "catA", "nodeA")
+	occupant := testPod("occupant", "catB", "nodeA")
+	if got, err := m.Attack(attacker, occupant); err != nil || got != 4.0 {
+		t.Fatalf("Attack() = (%f, %v), want (4.0, nil) from the gold-specific profile", got, err)
+	}
+}
+
+func TestModelAttackFallsBackToDefaultWhenSpecificProfileDoesNotCoverPair(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "nodeA", Labels: map[string]string{"sku": "gold"}}}
+	m := newTestModel(t, node)
+
+	// gold-override matches nodeA but only covers a different category
+	// pair; default should still be consulted instead of erroring out
+	// the moment the first matching profile fails to cover the pair.
+	m.rebuild([]*activ1alpha1.SlowdownProfile{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: activ1alpha1.SlowdownProfileSpec{
+				Entries: []activ1alpha1.SlowdownEntry{
+					{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 1.5},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "gold-override"},
+			Spec: activ1alpha1.SlowdownProfileSpec{
+				NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"sku": "gold"}},
+				Entries: []activ1alpha1.SlowdownEntry{
+					{AttackerCategory: "catC", OccupantCategory: "catD", Slowdown: 4.0},
+				},
+			},
+		},
+	})
+
+	attacker := testPod("attacker", "catA", "nodeA")
+	occupant := testPod("occupant", "catB", "nodeA")
+	if got, err := m.Attack(attacker, occupant); err != nil || got != 1.5 {
+		t.Fatalf("Attack() = (%f, %v), want (1.5, nil) from the default profile", got, err)
+	}
+}
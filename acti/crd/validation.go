@@ -0,0 +1,56 @@
+package crd
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+)
+
+// ValidateCoverage checks that profile's Entries cover every
+// (attackerCategory, occupantCategory) pair that already appears among
+// scheduledPods (as labeled by actiLabelKey), returning an error describing
+// the first missing pair otherwise. It is meant to be called from a
+// ValidatingAdmissionWebhook handler for SlowdownProfile CREATE/UPDATE, so
+// that a profile can never be accepted while leaving already-scheduled Pods
+// without coverage.
+func ValidateCoverage(actiLabelKey string, profile *activ1alpha1.SlowdownProfile, scheduledPods []corev1.Pod) error {
+	covered := make(map[string]map[string]bool, len(profile.Spec.Entries))
+	for _, e := range profile.Spec.Entries {
+		if covered[e.AttackerCategory] == nil {
+			covered[e.AttackerCategory] = make(map[string]bool)
+		}
+		covered[e.AttackerCategory][e.OccupantCategory] = true
+	}
+
+	categories := make(map[string]bool)
+	for _, pod := range scheduledPods {
+		if cat, ok := pod.Labels[actiLabelKey]; ok && cat != "" {
+			categories[cat] = true
+		}
+	}
+
+	for attacker := range categories {
+		for occupant := range categories {
+			if !covered[attacker][occupant] {
+				return fmt.Errorf("SlowdownProfile %q does not cover attacker category %q against occupant category %q, "+
+					"which is already in use by a scheduled Pod", profile.Name, attacker, occupant)
+			}
+		}
+	}
+	return nil
+}
+
+// validateNodeSelector rejects a SlowdownProfile whose NodeSelector cannot
+// be parsed, so invalid profiles never reach Model.rebuild.
+func validateNodeSelector(selector *metav1.LabelSelector) error {
+	if selector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+		return fmt.Errorf("invalid nodeSelector: %w", err)
+	}
+	return nil
+}
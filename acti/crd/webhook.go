@@ -0,0 +1,96 @@
+package crd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+)
+
+// Webhook is a ValidatingAdmissionWebhook handler for SlowdownProfile
+// CREATE/UPDATE, rejecting a profile whose NodeSelector doesn't parse or
+// whose Entries don't cover every category pair already in use by a
+// scheduled Pod, via validateNodeSelector and ValidateCoverage.
+type Webhook struct {
+	actiLabelKey string
+	podLister    corelisters.PodLister
+}
+
+// NewWebhook returns a Webhook that validates SlowdownProfiles against the
+// given actiLabelKey and the Pods tracked by podLister.
+func NewWebhook(actiLabelKey string, podLister corelisters.PodLister) *Webhook {
+	return &Webhook{actiLabelKey: actiLabelKey, podLister: podLister}
+}
+
+// ServeHTTP implements the ValidatingAdmissionWebhook HTTP contract: it
+// reads a single admissionv1.AdmissionReview request and writes back an
+// AdmissionReview carrying the admission decision.
+func (wh *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var review admissionv1.AdmissionReview
+	if err := json.Unmarshal(body, &review); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode AdmissionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := admissionv1.AdmissionReview{
+		TypeMeta: review.TypeMeta,
+		Response: wh.admit(review.Request),
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		klog.ErrorS(err, "acti webhook: failed to encode AdmissionReview response")
+	}
+}
+
+func (wh *Webhook) admit(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	resp := &admissionv1.AdmissionResponse{UID: req.UID, Allowed: true}
+
+	var profile activ1alpha1.SlowdownProfile
+	if err := json.Unmarshal(req.Object.Raw, &profile); err != nil {
+		return deny(req.UID, fmt.Errorf("failed to decode SlowdownProfile: %w", err))
+	}
+
+	if err := validateNodeSelector(profile.Spec.NodeSelector); err != nil {
+		return deny(req.UID, err)
+	}
+
+	pods, err := wh.podLister.List(labels.Everything())
+	if err != nil {
+		return deny(req.UID, fmt.Errorf("failed to list Pods to validate coverage: %w", err))
+	}
+	scheduledPods := make([]corev1.Pod, 0, len(pods))
+	for _, p := range pods {
+		if p.Spec.NodeName != "" {
+			scheduledPods = append(scheduledPods, *p)
+		}
+	}
+
+	if err := ValidateCoverage(wh.actiLabelKey, &profile, scheduledPods); err != nil {
+		return deny(req.UID, err)
+	}
+
+	return resp
+}
+
+func deny(uid types.UID, err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		UID:     uid,
+		Allowed: false,
+		Result:  &metav1.Status{Message: err.Error()},
+	}
+}
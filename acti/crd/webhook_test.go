@@ -0,0 +1,124 @@
+package crd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+)
+
+func newTestWebhook(t *testing.T, pods ...*corev1.Pod) *Webhook {
+	t.Helper()
+
+	client := fake.NewSimpleClientset()
+	for _, p := range pods {
+		if _, err := client.CoreV1().Pods(p.Namespace).Create(context.Background(), p, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("failed to create test Pod: %v", err)
+		}
+	}
+	factory := informers.NewSharedInformerFactory(client, 0)
+	podLister := factory.Core().V1().Pods().Lister()
+	factory.Start(nil)
+	factory.WaitForCacheSync(nil)
+
+	return NewWebhook(testLabelKey, podLister)
+}
+
+func reviewFor(t *testing.T, profile *activ1alpha1.SlowdownProfile) *admissionv1.AdmissionReview {
+	t.Helper()
+	raw, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatalf("failed to marshal SlowdownProfile: %v", err)
+	}
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:    types.UID("test-uid"),
+			Object: runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func postReview(t *testing.T, wh *Webhook, review *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	t.Helper()
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("failed to marshal AdmissionReview: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	wh.ServeHTTP(rec, req)
+
+	var got admissionv1.AdmissionReview
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode AdmissionReview response: %v", err)
+	}
+	return got.Response
+}
+
+func TestWebhookAllowsProfileCoveringScheduledPods(t *testing.T) {
+	wh := newTestWebhook(t, testPod("occupant", "catB", "node1"), testPod("attacker", "catA", "node1"))
+
+	profile := &activ1alpha1.SlowdownProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: activ1alpha1.SlowdownProfileSpec{
+			Entries: []activ1alpha1.SlowdownEntry{
+				{AttackerCategory: "catA", OccupantCategory: "catB", Slowdown: 1.5},
+				{AttackerCategory: "catA", OccupantCategory: "catA", Slowdown: 1.0},
+				{AttackerCategory: "catB", OccupantCategory: "catA", Slowdown: 1.5},
+				{AttackerCategory: "catB", OccupantCategory: "catB", Slowdown: 1.0},
+			},
+		},
+	}
+
+	resp := postReview(t, wh, reviewFor(t, profile))
+	if !resp.Allowed {
+		t.Fatalf("expected profile to be allowed, got denied: %v", resp.Result)
+	}
+}
+
+func TestWebhookRejectsProfileMissingCoverage(t *testing.T) {
+	wh := newTestWebhook(t, testPod("occupant", "catB", "node1"), testPod("attacker", "catA", "node1"))
+
+	profile := &activ1alpha1.SlowdownProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "incomplete"},
+		Spec:       activ1alpha1.SlowdownProfileSpec{Entries: nil},
+	}
+
+	resp := postReview(t, wh, reviewFor(t, profile))
+	if resp.Allowed {
+		t.Fatal("expected profile with no coverage to be denied, got allowed")
+	}
+}
+
+func TestWebhookRejectsInvalidNodeSelector(t *testing.T) {
+	wh := newTestWebhook(t)
+
+	profile := &activ1alpha1.SlowdownProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad-selector"},
+		Spec: activ1alpha1.SlowdownProfileSpec{
+			NodeSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "sku", Operator: "NotAnOperator"},
+				},
+			},
+		},
+	}
+
+	resp := postReview(t, wh, reviewFor(t, profile))
+	if resp.Allowed {
+		t.Fatal("expected profile with an invalid NodeSelector to be denied, got allowed")
+	}
+}
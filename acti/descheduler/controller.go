@@ -0,0 +1,113 @@
+// Package descheduler implements a background controller that continuously
+// monitors already-scheduled Pods tracked by ActiPlugin and evicts
+// colocations whose observed interference exceeds a configured per-category
+// slowdown budget, the same way a descheduler strategy would.
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"github.com/ckatsak/actischedulertest01/acti"
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+)
+
+// Controller watches Pods labeled with the configured actiLabelKey across
+// the cluster and evicts the smallest set of Pods needed to bring every
+// node's colocations back within its configured slowdown budget.
+//
+// Controller is safe to run under leader election: callers should only
+// invoke Run after becoming the leader, and cancel its context upon losing
+// leadership (see RunOrDie for a convenience wrapper that does this).
+type Controller struct {
+	client       kubernetes.Interface
+	recorder     record.EventRecorder
+	actiLabelKey string
+	model        acti.InterferenceModel
+	config       configv1alpha1.ActiConfig
+	interval     time.Duration
+	evictor      *evictor
+}
+
+// New returns a new Controller that polls every interval, using model to
+// predict interference among co-tenants and config to decide when and how
+// aggressively to evict.
+func New(
+	client kubernetes.Interface,
+	recorder record.EventRecorder,
+	actiLabelKey string,
+	model acti.InterferenceModel,
+	config configv1alpha1.ActiConfig,
+	interval time.Duration,
+) *Controller {
+	return &Controller{
+		client:       client,
+		recorder:     recorder,
+		actiLabelKey: actiLabelKey,
+		model:        model,
+		config:       config,
+		interval:     interval,
+		evictor:      newEvictor(client, recorder, config.MaxEvictionsPerMinute, config.DryRun),
+	}
+}
+
+// Run executes the descheduling loop every c.interval, until ctx is
+// cancelled. It never returns an error; failures of individual rounds are
+// logged and the loop keeps going.
+func (c *Controller) Run(ctx context.Context) {
+	if c.config.MaxEvictionsPerMinute <= 0 {
+		klog.InfoS("descheduler: disabled (maxEvictionsPerMinute <= 0)")
+		return
+	}
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.runOnce(ctx); err != nil {
+				klog.ErrorS(err, "descheduler: round failed")
+			}
+		}
+	}
+}
+
+func (c *Controller) runOnce(ctx context.Context) error {
+	pods, err := c.client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: c.actiLabelKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list Pods labeled %q: %w", c.actiLabelKey, err)
+	}
+
+	byNode := make(map[string][]*corev1.Pod)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		byNode[pod.Spec.NodeName] = append(byNode[pod.Spec.NodeName], pod)
+	}
+
+	for node, nodePods := range byNode {
+		victims, err := c.selectVictims(nodePods)
+		if err != nil {
+			klog.ErrorS(err, "descheduler: failed to select victims", "node", node)
+			continue
+		}
+		for _, v := range victims {
+			if err := c.evictor.Evict(ctx, v.pod, v.reason); err != nil {
+				klog.ErrorS(err, "descheduler: failed to evict Pod", "pod", v.pod.Name, "namespace", v.pod.Namespace, "node", node)
+			}
+		}
+	}
+	return nil
+}
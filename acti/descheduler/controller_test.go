@@ -0,0 +1,118 @@
+package descheduler
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+)
+
+const testLabelKey = "acti.ckatsak.io/category"
+
+// fakeModel is a minimal acti.InterferenceModel used purely to drive the
+// victim-selection tests with known, predictable slowdowns.
+type fakeModel struct {
+	slowdowns map[string]float64 // keyed by "attackerCategory->occupantCategory"
+}
+
+func (m *fakeModel) Attack(attacker, occupant *corev1.Pod) (float64, error) {
+	key := attacker.Labels[testLabelKey] + "->" + occupant.Labels[testLabelKey]
+	return m.slowdowns[key], nil
+}
+
+func (m *fakeModel) ToInt64Multiplier() float64 { return 100 }
+
+func newTestPod(name, category, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			UID:    types.UID(name),
+			Labels: map[string]string{testLabelKey: category},
+		},
+		Spec: corev1.PodSpec{NodeName: node},
+	}
+}
+
+func newTestController(model *fakeModel, budgets map[string]float64) *Controller {
+	client := fake.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(16)
+	return New(client, recorder, testLabelKey, model, configv1alpha1.ActiConfig{
+		CategorySlowdownBudget: budgets,
+		MaxEvictionsPerMinute:  60,
+	}, 0)
+}
+
+func TestSelectVictimsNoneOverBudget(t *testing.T) {
+	model := &fakeModel{slowdowns: map[string]float64{
+		"catA->catB": 1.1,
+		"catB->catA": 1.1,
+	}}
+	c := newTestController(model, map[string]float64{"catA": 5, "catB": 5})
+
+	pods := []*corev1.Pod{
+		newTestPod("p1", "catA", "node1"),
+		newTestPod("p2", "catB", "node1"),
+	}
+
+	victims, err := c.selectVictims(pods)
+	if err != nil {
+		t.Fatalf("selectVictims returned unexpected error: %v", err)
+	}
+	if len(victims) != 0 {
+		t.Errorf("selectVictims() = %v, want no victims", victims)
+	}
+}
+
+func TestSelectVictimsEvictsBiggestOffender(t *testing.T) {
+	// p1 (catA) hammers both p2 and p3 well past their budgets; p2 and p3
+	// barely affect each other.
+	model := &fakeModel{slowdowns: map[string]float64{
+		"catA->catB": 5.0,
+		"catA->catC": 5.0,
+		"catB->catA": 1.0,
+		"catC->catA": 1.0,
+		"catB->catC": 1.01,
+		"catC->catB": 1.01,
+	}}
+	c := newTestController(model, map[string]float64{"catA": 100, "catB": 2, "catC": 2})
+
+	pods := []*corev1.Pod{
+		newTestPod("p1", "catA", "node1"),
+		newTestPod("p2", "catB", "node1"),
+		newTestPod("p3", "catC", "node1"),
+	}
+
+	victims, err := c.selectVictims(pods)
+	if err != nil {
+		t.Fatalf("selectVictims returned unexpected error: %v", err)
+	}
+	if len(victims) != 1 || victims[0].pod.Name != "p1" {
+		t.Fatalf("selectVictims() = %v, want exactly p1 evicted", victims)
+	}
+}
+
+func TestEvictorDryRunDoesNotCallAPI(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(1)
+	e := newEvictor(client, recorder, 60, true)
+
+	pod := newTestPod("p1", "catA", "node1")
+	if err := e.Evict(context.Background(), pod, "test"); err != nil {
+		t.Fatalf("Evict returned unexpected error: %v", err)
+	}
+
+	select {
+	case ev := <-recorder.Events:
+		if ev == "" {
+			t.Error("expected a recorded dry-run Event")
+		}
+	default:
+		t.Error("expected a recorded dry-run Event, got none")
+	}
+}
@@ -0,0 +1,74 @@
+package descheduler
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+)
+
+// evictor issues Pod evictions through the Eviction subresource, honoring
+// PodDisruptionBudgets (the API server rejects the eviction with a 429 when
+// it would violate one) and a cluster-wide rate limit, optionally without
+// ever calling the API when dryRun is set.
+type evictor struct {
+	client   kubernetes.Interface
+	recorder record.EventRecorder
+	limiter  *rate.Limiter
+	dryRun   bool
+}
+
+func newEvictor(client kubernetes.Interface, recorder record.EventRecorder, maxPerMinute int32, dryRun bool) *evictor {
+	if maxPerMinute <= 0 {
+		maxPerMinute = 1
+	}
+	return &evictor{
+		client:   client,
+		recorder: recorder,
+		limiter:  rate.NewLimiter(rate.Limit(float64(maxPerMinute)/60.0), int(maxPerMinute)),
+		dryRun:   dryRun,
+	}
+}
+
+// Evict evicts pod, recording reason as an Event regardless of the outcome.
+// It blocks until the rate limiter admits the eviction, respecting ctx's
+// cancellation.
+func (e *evictor) Evict(ctx context.Context, pod *corev1.Pod, reason string) error {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limiter wait: %w", err)
+	}
+
+	if e.dryRun {
+		klog.InfoS("descheduler: dry-run, would evict Pod", "pod", pod.Name, "namespace", pod.Namespace, "reason", reason)
+		e.recorder.Eventf(pod, corev1.EventTypeNormal, "DryRunEviction", reason)
+		return nil
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+	err := e.client.PolicyV1().Evictions(pod.Namespace).Evict(ctx, eviction)
+	switch {
+	case err == nil:
+		klog.InfoS("descheduler: evicted Pod", "pod", pod.Name, "namespace", pod.Namespace, "reason", reason)
+		e.recorder.Eventf(pod, corev1.EventTypeNormal, "Evicted", reason)
+		return nil
+	case apierrors.IsTooManyRequests(err):
+		// A PodDisruptionBudget blocked this eviction; leave the Pod
+		// alone and let the next round re-evaluate it.
+		e.recorder.Eventf(pod, corev1.EventTypeWarning, "EvictionBlocked", "eviction blocked by PodDisruptionBudget: %v", err)
+		return nil
+	default:
+		return fmt.Errorf("failed to evict Pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+}
@@ -0,0 +1,45 @@
+package descheduler
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+)
+
+// RunOrDie runs c.Run under leader election, so that at most one replica of
+// the descheduler loop is active across the cluster at any time. identity
+// should uniquely identify this process (e.g. its Pod name).
+func (c *Controller) RunOrDie(ctx context.Context, client kubernetes.Interface, identity, lockNamespace string) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      "acti-descheduler",
+			Namespace: lockNamespace,
+		},
+		Client: client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				klog.InfoS("descheduler: acquired leadership, starting loop", "identity", identity)
+				c.Run(leaderCtx)
+			},
+			OnStoppedLeading: func() {
+				klog.InfoS("descheduler: lost leadership, stopping loop", "identity", identity)
+			},
+		},
+	})
+}
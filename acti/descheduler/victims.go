@@ -0,0 +1,125 @@
+package descheduler
+
+import (
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// victim is a Pod selected for eviction, along with a human-readable reason
+// recorded on the eviction Event.
+type victim struct {
+	pod    *corev1.Pod
+	reason string
+}
+
+// selectVictims computes, for every Pod in nodePods, the aggregate predicted
+// slowdown inflicted on it by every other co-tenant on the same node, and
+// greedily selects the smallest set of eviction victims that brings every
+// remaining Pod's aggregate slowdown back within its category's configured
+// budget.
+//
+// At each iteration, the candidate contributing the highest total attack
+// against the rest of the Pods (i.e. the biggest net offender, not merely the
+// biggest victim) is evicted, and aggregates are recomputed, until either no
+// Pod exceeds its budget or a single Pod remains.
+func (c *Controller) selectVictims(nodePods []*corev1.Pod) ([]victim, error) {
+	remaining := make([]*corev1.Pod, len(nodePods))
+	copy(remaining, nodePods)
+
+	var victims []victim
+	for len(remaining) > 1 {
+		aggregate, err := c.aggregateSlowdowns(remaining)
+		if err != nil {
+			return nil, err
+		}
+
+		overBudget := false
+		for _, pod := range remaining {
+			if aggregate[pod.UID] > c.budgetFor(pod) {
+				overBudget = true
+				break
+			}
+		}
+		if !overBudget {
+			break
+		}
+
+		offender := c.biggestOffender(remaining, remaining)
+		victims = append(victims, victim{
+			pod:    offender,
+			reason: fmt.Sprintf("aggregate predicted slowdown on co-tenants exceeds budget on node %q", offender.Spec.NodeName),
+		})
+		remaining = removePod(remaining, offender)
+	}
+	return victims, nil
+}
+
+// aggregateSlowdowns returns, for every Pod's UID in pods, the sum of
+// c.model.Attack(other, pod) over every other Pod in pods.
+func (c *Controller) aggregateSlowdowns(pods []*corev1.Pod) (map[types.UID]float64, error) {
+	aggregate := make(map[types.UID]float64, len(pods))
+	for _, occupant := range pods {
+		var sum float64
+		for _, attacker := range pods {
+			if attacker.UID == occupant.UID {
+				continue
+			}
+			slowdown, err := c.model.Attack(attacker, occupant)
+			if err != nil {
+				return nil, fmt.Errorf("failed to estimate attack of %q on %q: %w", attacker.Name, occupant.Name, err)
+			}
+			sum += slowdown
+		}
+		aggregate[occupant.UID] = sum
+	}
+	return aggregate, nil
+}
+
+// budgetFor returns the configured slowdown budget for pod's category, or
+// +Inf when the category is absent from the configuration (i.e. it is never
+// considered for eviction).
+func (c *Controller) budgetFor(pod *corev1.Pod) float64 {
+	category := pod.Labels[c.actiLabelKey]
+	if budget, ok := c.config.CategorySlowdownBudget[category]; ok {
+		return budget
+	}
+	return math.Inf(1)
+}
+
+// biggestOffender returns the Pod in candidates whose presence contributes
+// the highest total attack against the rest of victims.
+func (c *Controller) biggestOffender(candidates, victims []*corev1.Pod) *corev1.Pod {
+	var best *corev1.Pod
+	bestScore := -1.0
+	for _, attacker := range candidates {
+		score := 0.0
+		for _, occupant := range victims {
+			if occupant.UID == attacker.UID {
+				continue
+			}
+			s, err := c.model.Attack(attacker, occupant)
+			if err != nil {
+				continue
+			}
+			score += s
+		}
+		if score > bestScore {
+			bestScore = score
+			best = attacker
+		}
+	}
+	return best
+}
+
+func removePod(pods []*corev1.Pod, target *corev1.Pod) []*corev1.Pod {
+	out := make([]*corev1.Pod, 0, len(pods)-1)
+	for _, p := range pods {
+		if p.UID != target.UID {
+			out = append(out, p)
+		}
+	}
+	return out
+}
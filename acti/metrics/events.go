@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// RecordSchedulingDecision records an Event on pod summarizing the predicted
+// aggregate slowdown InterferenceModel.Attack estimated for pod on every
+// node that was still a candidate by the time Score ran, so operators can
+// post-hoc understand why the Pod landed where it did.
+//
+// nodeSlowdowns maps candidate node name to the aggregate predicted slowdown
+// Pod would incur (or inflict) there.
+func RecordSchedulingDecision(recorder record.EventRecorder, pod *corev1.Pod, nodeSlowdowns map[string]float64) {
+	if len(nodeSlowdowns) == 0 {
+		return
+	}
+
+	nodes := make([]string, 0, len(nodeSlowdowns))
+	for node := range nodeSlowdowns {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	parts := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		parts = append(parts, fmt.Sprintf("%s=%.3f", node, nodeSlowdowns[node]))
+	}
+
+	recorder.Eventf(pod, corev1.EventTypeNormal, "PredictedSlowdowns",
+		"predicted aggregate slowdown per candidate node: %s", strings.Join(parts, ", "))
+}
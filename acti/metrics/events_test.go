@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestRecordSchedulingDecisionEmitsEvent(t *testing.T) {
+	recorder := record.NewFakeRecorder(1)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"}}
+
+	RecordSchedulingDecision(recorder, pod, map[string]float64{"node2": 1.8, "node1": 1.2})
+
+	select {
+	case ev := <-recorder.Events:
+		if !strings.Contains(ev, "node1=1.200") || !strings.Contains(ev, "node2=1.800") {
+			t.Errorf("recorded Event = %q, want it to mention both nodes' slowdowns", ev)
+		}
+	default:
+		t.Fatal("expected an Event to be recorded, got none")
+	}
+}
+
+func TestRecordSchedulingDecisionNoopWithoutCandidates(t *testing.T) {
+	recorder := record.NewFakeRecorder(1)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"}}
+
+	RecordSchedulingDecision(recorder, pod, nil)
+
+	select {
+	case ev := <-recorder.Events:
+		t.Fatalf("expected no Event, got %q", ev)
+	default:
+	}
+}
@@ -0,0 +1,82 @@
+// Package metrics instruments ActiPlugin with Prometheus collectors,
+// registered with kube-scheduler's own legacyregistry so they are exposed
+// alongside the scheduler's metrics, and optionally also on a dedicated
+// --acti-metrics-bind-address.
+package metrics
+
+import (
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const subsystem = "acti"
+
+var (
+	// AttackSlowdown observes the slowdown estimate returned by
+	// InterferenceModel.Attack every time it is called during Filter or
+	// Score.
+	AttackSlowdown = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "attack_slowdown",
+			Help:      "Slowdown estimated by InterferenceModel.Attack for a candidate colocation, by attacker/occupant category and node.",
+			Buckets:   []float64{1.0, 1.1, 1.25, 1.5, 2.0, 3.0, 5.0, 10.0},
+		},
+		[]string{"attacker", "occupant", "node"},
+	)
+
+	// SchedulingDecisions counts scheduling outcomes for Pods tracked by
+	// ActiPlugin.
+	SchedulingDecisions = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "scheduling_decisions_total",
+			Help:      "Number of scheduling decisions made by ActiPlugin, by result.",
+		},
+		[]string{"result"},
+	)
+
+	// NodePredictedAggregateSlowdown is updated on each successful bind
+	// with the aggregate predicted slowdown of all co-tenants of the
+	// given category on the given node.
+	NodePredictedAggregateSlowdown = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "node_predicted_aggregate_slowdown",
+			Help:      "Aggregate predicted slowdown of the Pods of a given category on a node, as of the last successful bind.",
+		},
+		[]string{"node", "category"},
+	)
+
+	// ModelLookupErrors counts InterferenceModel.Attack calls that
+	// returned an error (e.g. an unknown category, or a CRD-backed model
+	// missing coverage for a category pair).
+	ModelLookupErrors = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "model_lookup_errors_total",
+			Help:      "Number of InterferenceModel.Attack calls that returned an error.",
+		},
+	)
+)
+
+// Register registers all of the above collectors with kube-scheduler's
+// legacyregistry, so they are exposed on the same /metrics endpoint as the
+// rest of kube-scheduler's metrics. It is safe to call more than once.
+func Register() {
+	legacyregistry.MustRegister(AttackSlowdown)
+	legacyregistry.MustRegister(SchedulingDecisions)
+	legacyregistry.MustRegister(NodePredictedAggregateSlowdown)
+	legacyregistry.MustRegister(ModelLookupErrors)
+}
+
+// ObserveAttack is a convenience wrapper around AttackSlowdown that also
+// increments ModelLookupErrors when the model returned an error, so callers
+// at the Filter/Score call site only need one call.
+func ObserveAttack(attackerCategory, occupantCategory, node string, slowdown float64, err error) {
+	if err != nil {
+		ModelLookupErrors.Inc()
+		return
+	}
+	AttackSlowdown.WithLabelValues(attackerCategory, occupantCategory, node).Observe(slowdown)
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestObserveAttackRecordsSuccess(t *testing.T) {
+	AttackSlowdown.Reset()
+	ModelLookupErrors.Reset()
+
+	ObserveAttack("catA", "catB", "node1", 1.5, nil)
+
+	count := testutil.CollectAndCount(AttackSlowdown)
+	if count != 1 {
+		t.Errorf("CollectAndCount(AttackSlowdown) = %d, want 1", count)
+	}
+	if got := testutil.ToFloat64(ModelLookupErrors); got != 0 {
+		t.Errorf("ToFloat64(ModelLookupErrors) = %f, want 0", got)
+	}
+}
+
+func TestObserveAttackRecordsLookupError(t *testing.T) {
+	AttackSlowdown.Reset()
+	ModelLookupErrors.Reset()
+
+	ObserveAttack("catA", "catB", "node1", -1, errors.New("no coverage"))
+
+	if got := testutil.ToFloat64(ModelLookupErrors); got != 1 {
+		t.Errorf("ToFloat64(ModelLookupErrors) = %f, want 1", got)
+	}
+	if count := testutil.CollectAndCount(AttackSlowdown); count != 0 {
+		t.Errorf("CollectAndCount(AttackSlowdown) = %d, want 0 (error should not be observed)", count)
+	}
+}
+
+func TestSchedulingDecisionsCounter(t *testing.T) {
+	SchedulingDecisions.Reset()
+
+	SchedulingDecisions.WithLabelValues("scheduled").Inc()
+	SchedulingDecisions.WithLabelValues("scheduled").Inc()
+	SchedulingDecisions.WithLabelValues("unschedulable").Inc()
+
+	if got := testutil.ToFloat64(SchedulingDecisions.WithLabelValues("scheduled")); got != 2 {
+		t.Errorf("ToFloat64(scheduled) = %f, want 2", got)
+	}
+	if got := testutil.ToFloat64(SchedulingDecisions.WithLabelValues("unschedulable")); got != 1 {
+		t.Errorf("ToFloat64(unschedulable) = %f, want 1", got)
+	}
+}
+
+func TestNodePredictedAggregateSlowdownGauge(t *testing.T) {
+	NodePredictedAggregateSlowdown.Reset()
+
+	NodePredictedAggregateSlowdown.WithLabelValues("node1", "catA").Set(2.5)
+	if got := testutil.ToFloat64(NodePredictedAggregateSlowdown.WithLabelValues("node1", "catA")); got != 2.5 {
+		t.Errorf("ToFloat64(node1,catA) = %f, want 2.5", got)
+	}
+}
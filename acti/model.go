@@ -0,0 +1,78 @@
+package acti
+
+import (
+	"context"
+	"fmt"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+	"github.com/ckatsak/actischedulertest01/acti/crd"
+	"github.com/ckatsak/actischedulertest01/acti/hardcoded"
+	"github.com/ckatsak/actischedulertest01/acti/pmu"
+	"github.com/ckatsak/actischedulertest01/acti/power"
+)
+
+// BuildModel constructs the InterferenceModel selected by cfg.ModelType,
+// defaulting to hardcoded.HardcodedSlowDowns when cfg.ModelType is empty (the
+// zero value of configv1alpha1.ModelType). ModelTypeCRD starts crd.Model's
+// SlowdownProfile informer via crdClient and blocks until its cache has
+// synced, the same way crd.Model.Run's own doc comment describes; nodeLister
+// is used both by ModelTypeCRD (to evaluate a SlowdownProfile's
+// NodeSelector) and, unconditionally, as the fallback every other model
+// defers to once it lacks the data to produce an estimate itself.
+func BuildModel(
+	ctx context.Context,
+	cfg configv1alpha1.ActiConfig,
+	labelKey string,
+	nodeLister corelisters.NodeLister,
+	crdClient activ1alpha1.Interface,
+) (InterferenceModel, error) {
+	fallback := hardcoded.New(labelKey)
+
+	switch cfg.ModelType {
+	case configv1alpha1.ModelTypePMU:
+		var coeffs pmu.Coefficients
+		if cfg.PMU != nil {
+			coeffs = pmu.Coefficients{
+				Alpha:          cfg.PMU.Alpha,
+				Beta:           cfg.PMU.Beta,
+				NodeMBWCapMBps: cfg.PMU.NodeMBWCapMBps,
+			}
+		}
+		return pmu.New(labelKey, coeffs, fallback), nil
+
+	case configv1alpha1.ModelTypeCRD:
+		model := crd.New(labelKey, nodeLister)
+		if err := model.Run(ctx, crdClient); err != nil {
+			return nil, fmt.Errorf("acti: failed to start crd.Model: %w", err)
+		}
+		return model, nil
+
+	default:
+		return fallback, nil
+	}
+}
+
+// WithPowerOverlay wraps multiModel in power.AnnotationAdapter when cfg.Power
+// is set, so the Score extension point's PowerDeltaWatts dimension is driven
+// by node annotations published by an out-of-tree power-monitoring agent
+// instead of defaulting to zero. It returns multiModel unchanged when
+// cfg.Power is nil.
+func WithPowerOverlay(
+	cfg configv1alpha1.ActiConfig,
+	labelKey string,
+	multiModel MultiObjectiveModel,
+	nodeLister corelisters.NodeLister,
+) MultiObjectiveModel {
+	if cfg.Power == nil {
+		return multiModel
+	}
+	return power.AnnotationAdapter{
+		Base:          multiModel,
+		ActiLabelKey:  labelKey,
+		CategoryWatts: power.CategoryWatts(cfg.Power.CategoryWatts),
+		NodeLister:    nodeLister,
+	}
+}
@@ -0,0 +1,45 @@
+package acti
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/ckatsak/actischedulertest01/acti/objective"
+)
+
+// ObjectiveVector is the multi-dimensional counterpart of the single scalar
+// slowdown returned by InterferenceModel.Attack, used by the Score extension
+// point to combine several predicted effects of a colocation into one
+// Score. It is an alias of objective.ObjectiveVector so that
+// MultiObjectiveModel implementations living outside this package (e.g.
+// acti/power.AnnotationAdapter, which wraps another MultiObjectiveModel)
+// don't need to import acti itself to produce or consume one.
+type ObjectiveVector = objective.ObjectiveVector
+
+// MultiObjectiveModel is the multi-dimensional counterpart of
+// InterferenceModel: implementations estimate several independent effects of
+// colocating attacker with occupant, rather than a single scalar slowdown.
+// It is an alias of objective.MultiObjectiveModel; see that package's doc
+// comment for why it isn't declared directly here.
+type MultiObjectiveModel = objective.MultiObjectiveModel
+
+// InterferenceModelAdapter adapts an InterferenceModel into a
+// MultiObjectiveModel, so existing models (e.g. hardcoded.HardcodedSlowDowns)
+// keep working unmodified with the Score extension point: every dimension
+// but Slowdown is reported as zero.
+type InterferenceModelAdapter struct {
+	Model InterferenceModel
+}
+
+// AttackVector implements MultiObjectiveModel.
+func (a InterferenceModelAdapter) AttackVector(attacker, occupant *corev1.Pod) (ObjectiveVector, error) {
+	slowdown, err := a.Model.Attack(attacker, occupant)
+	if err != nil {
+		return ObjectiveVector{}, err
+	}
+	return ObjectiveVector{Slowdown: slowdown}, nil
+}
+
+// ToInt64Multiplier implements MultiObjectiveModel.
+func (a InterferenceModelAdapter) ToInt64Multiplier() float64 {
+	return a.Model.ToInt64Multiplier()
+}
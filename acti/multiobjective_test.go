@@ -0,0 +1,43 @@
+package acti
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeInterferenceModel struct {
+	slowdown float64
+	err      error
+}
+
+func (m fakeInterferenceModel) Attack(_, _ *corev1.Pod) (float64, error) {
+	return m.slowdown, m.err
+}
+
+func (m fakeInterferenceModel) ToInt64Multiplier() float64 { return 100 }
+
+func TestInterferenceModelAdapterZeroesOtherDimensions(t *testing.T) {
+	adapter := InterferenceModelAdapter{Model: fakeInterferenceModel{slowdown: 2.5}}
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	v, err := adapter.AttackVector(pod, pod)
+	if err != nil {
+		t.Fatalf("AttackVector returned unexpected error: %v", err)
+	}
+	want := ObjectiveVector{Slowdown: 2.5}
+	if v != want {
+		t.Errorf("AttackVector() = %+v, want %+v", v, want)
+	}
+}
+
+func TestInterferenceModelAdapterPropagatesError(t *testing.T) {
+	adapter := InterferenceModelAdapter{Model: fakeInterferenceModel{err: errors.New("boom")}}
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	if _, err := adapter.AttackVector(pod, pod); err == nil {
+		t.Fatal("AttackVector() expected an error, got nil")
+	}
+}
@@ -0,0 +1,44 @@
+// Package objective defines ObjectiveVector and MultiObjectiveModel, kept in
+// their own leaf package (rather than package acti) so MultiObjectiveModel
+// implementations that wrap another one, like acti/power.AnnotationAdapter,
+// can depend on them without importing acti itself.
+package objective
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ObjectiveVector is the multi-dimensional counterpart of the single scalar
+// slowdown returned by acti.InterferenceModel.Attack, used by the Score
+// extension point to combine several predicted effects of a colocation into
+// one Score.
+type ObjectiveVector struct {
+	// Slowdown is the same estimate acti.InterferenceModel.Attack would
+	// return: the multiplicative slowdown occupant incurs when colocated
+	// with attacker.
+	Slowdown float64
+	// PowerDeltaWatts is the additional power draw, in Watts, the node
+	// is predicted to incur from the colocation.
+	PowerDeltaWatts float64
+	// MemBWContentionMBps is the predicted memory-bandwidth contention,
+	// in MB/s, between attacker and occupant.
+	MemBWContentionMBps float64
+	// LLCPressure is a unitless estimate of last-level-cache pressure
+	// the colocation is predicted to cause.
+	LLCPressure float64
+}
+
+// MultiObjectiveModel is the multi-dimensional counterpart of
+// acti.InterferenceModel: implementations estimate several independent
+// effects of colocating attacker with occupant, rather than a single scalar
+// slowdown.
+type MultiObjectiveModel interface {
+	// AttackVector returns the predicted ObjectiveVector of colocating
+	// attacker with occupant. It returns an error under the same
+	// conditions acti.InterferenceModel.Attack would.
+	AttackVector(attacker, occupant *corev1.Pod) (ObjectiveVector, error)
+
+	// ToInt64Multiplier implements the same contract as
+	// acti.InterferenceModel.ToInt64Multiplier.
+	ToInt64Multiplier() float64
+}
@@ -0,0 +1,212 @@
+// Package acti implements ActiPlugin, a kube-scheduler Filter/Score plugin
+// that accounts for interference between co-located Pods: Filter rejects a
+// node when the configured InterferenceModel cannot estimate the resulting
+// slowdown, and Score ranks candidate nodes by the aggregate slowdown a Pod
+// would incur from (and inflict on) its prospective co-tenants there.
+package acti
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+	"github.com/ckatsak/actischedulertest01/acti/metrics"
+)
+
+// Name is the name ActiPlugin is registered under with kube-scheduler.
+const Name = "ActiPlugin"
+
+// LabelKey is the Pod label ActiPlugin uses to track the application
+// category of the Pods it schedules; every other package in this module
+// (hardcoded, pmu, crd, descheduler, metrics) is parameterized by the same
+// key, rather than hardcoding it, so they stay in sync with whatever this
+// constant is configured as.
+const LabelKey = "acti.ckatsak.io/category"
+
+// InterferenceModel estimates the slowdown a Pod (the "occupant") incurs
+// when colocated with another Pod (the "attacker") on the same node.
+// Implementations include hardcoded.HardcodedSlowDowns, pmu.Model and
+// crd.Model.
+type InterferenceModel interface {
+	// Attack returns the multiplicative slowdown occupant incurs when
+	// colocated with attacker (e.g. 1.5 means 50% slower), or an error
+	// when the model cannot produce an estimate (e.g. an unknown
+	// category, or no SlowdownProfile coverage).
+	Attack(attacker, occupant *corev1.Pod) (float64, error)
+
+	// ToInt64Multiplier returns the factor Score uses to convert a
+	// floating-point slowdown into the int64 range a Score plugin must
+	// return.
+	ToInt64Multiplier() float64
+}
+
+// Plugin implements framework.FilterPlugin and framework.ScorePlugin.
+type Plugin struct {
+	handle   framework.Handle
+	labelKey string
+
+	model        InterferenceModel
+	multiModel   MultiObjectiveModel
+	scoreWeights configv1alpha1.ScoreWeights
+	recorder     record.EventRecorder
+}
+
+var (
+	_ framework.FilterPlugin    = &Plugin{}
+	_ framework.ScorePlugin     = &Plugin{}
+	_ framework.ScoreExtensions = &Plugin{}
+	_ framework.PostBindPlugin  = &Plugin{}
+)
+
+// instrumentedModel wraps an InterferenceModel, recording every Attack call
+// via acti/metrics, so Filter and Score don't need to duplicate that
+// bookkeeping at each call site.
+type instrumentedModel struct {
+	InterferenceModel
+	labelKey string
+}
+
+// Attack implements InterferenceModel.
+func (m instrumentedModel) Attack(attacker, occupant *corev1.Pod) (float64, error) {
+	slowdown, err := m.InterferenceModel.Attack(attacker, occupant)
+	metrics.ObserveAttack(attacker.Labels[m.labelKey], occupant.Labels[m.labelKey], occupant.Spec.NodeName, slowdown, err)
+	return slowdown, err
+}
+
+// Name implements framework.Plugin.
+func (p *Plugin) Name() string { return Name }
+
+// New is the framework.PluginFactory for ActiPlugin, registered via
+// sched.WithPlugin(acti.Name, acti.New) in cmd/kube-scheduler/main.go. It
+// constructs the InterferenceModel selected by ActiConfig.ModelType (see
+// BuildModel), defaulting to hardcoded.HardcodedSlowDowns, and defaults to
+// all Score weight on Slowdown; pass a non-zero ActiConfig.ScoreWeights to
+// combine other MultiObjectiveModel dimensions instead.
+func New(ctx context.Context, obj runtime.Object, h framework.Handle) (framework.Plugin, error) {
+	var cfg configv1alpha1.ActiConfig
+	if c, ok := obj.(*configv1alpha1.ActiConfig); ok && c != nil {
+		cfg = *c
+	}
+	weights := configv1alpha1.ScoreWeights{WSlow: 1}
+	if cfg.ScoreWeights != (configv1alpha1.ScoreWeights{}) {
+		weights = cfg.ScoreWeights
+	}
+
+	nodeLister := h.SharedInformerFactory().Core().V1().Nodes().Lister()
+
+	var crdClient activ1alpha1.Interface
+	if cfg.ModelType == configv1alpha1.ModelTypeCRD {
+		c, err := activ1alpha1.NewForConfig(h.KubeConfig())
+		if err != nil {
+			return nil, fmt.Errorf("acti: failed to build SlowdownProfile client: %w", err)
+		}
+		crdClient = c
+	}
+
+	baseModel, err := BuildModel(ctx, cfg, LabelKey, nodeLister, crdClient)
+	if err != nil {
+		klog.ErrorS(err, "acti: falling back to hardcoded model", "modelType", cfg.ModelType)
+		baseModel, _ = BuildModel(ctx, configv1alpha1.ActiConfig{}, LabelKey, nodeLister, nil)
+	}
+	model := instrumentedModel{InterferenceModel: baseModel, labelKey: LabelKey}
+
+	multiModel := WithPowerOverlay(cfg, LabelKey, InterferenceModelAdapter{Model: model}, nodeLister)
+
+	broadcaster := record.NewBroadcaster()
+	if client := h.ClientSet(); client != nil {
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: client.CoreV1().Events("")})
+	}
+	recorder := broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: Name})
+
+	return &Plugin{
+		handle:       h,
+		labelKey:     LabelKey,
+		model:        model,
+		multiModel:   multiModel,
+		scoreWeights: weights,
+		recorder:     recorder,
+	}, nil
+}
+
+// Filter implements framework.FilterPlugin: it rejects nodeInfo when the
+// configured InterferenceModel cannot produce a slowdown estimate for pod
+// against one of nodeInfo's existing tracked co-tenants (e.g. because the
+// model has no coverage for that category pair), since scheduling there
+// would leave ActiPlugin blind to the resulting interference.
+func (p *Plugin) Filter(_ context.Context, _ *framework.CycleState, pod *corev1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	if pod.Labels[p.labelKey] == "" {
+		return nil
+	}
+	for _, other := range nodeInfo.Pods {
+		occupant := other.Pod
+		if occupant.Labels[p.labelKey] == "" {
+			continue
+		}
+		if _, err := p.model.Attack(pod, occupant); err != nil {
+			metrics.SchedulingDecisions.WithLabelValues("unschedulable").Inc()
+			return framework.NewStatus(framework.Unschedulable, fmt.Sprintf("acti: %v", err))
+		}
+		if _, err := p.model.Attack(occupant, pod); err != nil {
+			metrics.SchedulingDecisions.WithLabelValues("unschedulable").Inc()
+			return framework.NewStatus(framework.Unschedulable, fmt.Sprintf("acti: %v", err))
+		}
+	}
+	return nil
+}
+
+// Score implements framework.ScorePlugin: it computes pod's aggregate
+// ObjectiveVector against every co-tenant already tracked on nodeName, via
+// ScoreWithModel. The raw value returned is only meaningful once combined
+// and normalized by NormalizeScore, below.
+func (p *Plugin) Score(ctx context.Context, state *framework.CycleState, pod *corev1.Pod, nodeName string) (int64, *framework.Status) {
+	nodeInfo, err := p.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		return 0, framework.AsStatus(fmt.Errorf("acti: failed to get NodeInfo for %q: %w", nodeName, err))
+	}
+
+	var coTenants []*corev1.Pod
+	for _, other := range nodeInfo.Pods {
+		if other.Pod.Labels[p.labelKey] != "" {
+			coTenants = append(coTenants, other.Pod)
+		}
+	}
+
+	return ScoreWithModel(ctx, p.multiModel, state, pod, nodeName, coTenants)
+}
+
+// ScoreExtensions implements framework.ScorePlugin.
+func (p *Plugin) ScoreExtensions() framework.ScoreExtensions {
+	return p
+}
+
+// NormalizeScore implements framework.ScoreExtensions, combining every
+// dimension Score stashed away via p.scoreWeights. Before doing so, it
+// records an Event on pod summarizing the predicted aggregate slowdown on
+// every node still a candidate at this point, so operators can post-hoc
+// understand why the Pod landed where it did.
+func (p *Plugin) NormalizeScore(ctx context.Context, state *framework.CycleState, pod *corev1.Pod, scores framework.NodeScoreList) *framework.Status {
+	if slowdowns, err := SlowdownsFromState(state); err == nil {
+		metrics.RecordSchedulingDecision(p.recorder, pod, slowdowns)
+	}
+	return NormalizeScoreWithWeights(ctx, state, pod, p.scoreWeights, scores)
+}
+
+// PostBind implements framework.PostBindPlugin: once pod is successfully
+// bound to nodeName, record the scheduling decision and update
+// NodePredictedAggregateSlowdown with the predicted aggregate slowdown this
+// category now carries on nodeName.
+func (p *Plugin) PostBind(_ context.Context, state *framework.CycleState, pod *corev1.Pod, nodeName string) {
+	metrics.SchedulingDecisions.WithLabelValues("scheduled").Inc()
+	if slowdowns, err := SlowdownsFromState(state); err == nil {
+		metrics.NodePredictedAggregateSlowdown.WithLabelValues(nodeName, pod.Labels[p.labelKey]).Set(slowdowns[nodeName])
+	}
+}
@@ -0,0 +1,133 @@
+//go:build linux
+
+// Package agent implements the per-node PMU sampling agent described by the
+// pmu package: it periodically samples hardware performance counters (via
+// libpfm4/perf_event_open) for every Pod running on the node, classifies each
+// into a pmu.ContentionVector, and publishes the result on the Pod's
+// annotations so that pmu.Model can read it back on the scheduler side.
+//
+// It is meant to run either as a privileged per-node DaemonSet, or in-process
+// in the scheduler itself when the scheduler is already running privileged.
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/ckatsak/actischedulertest01/acti/pmu"
+)
+
+// PodCounters identifies the cgroup backing a Pod's containers, so the agent
+// can attribute sampled counters to the right Pod.
+type PodCounters struct {
+	Namespace string
+	Name      string
+	UID       types.UID
+	// CgroupPath is the path (below the cgroupfs mountpoint) of the Pod's
+	// cgroup, used to resolve the PIDs to attach perf events to.
+	CgroupPath string
+}
+
+// Sampler periodically samples hardware performance counters for every Pod
+// on the local node over a rolling window, and publishes the resulting
+// pmu.ContentionVector on each Pod's annotations.
+type Sampler struct {
+	client     kubernetes.Interface
+	nodeName   string
+	window     time.Duration
+	listPods   func(ctx context.Context) ([]PodCounters, error)
+	readCounts func(pc PodCounters, window time.Duration) (rawCounters, error)
+}
+
+// NewSampler returns a new Sampler that samples over the given rolling
+// window and publishes results through client. listPods is used to discover
+// the Pods currently running on nodeName and their cgroup paths; it is
+// normally backed by the kubelet's local Pod resources endpoint.
+func NewSampler(client kubernetes.Interface, nodeName string, window time.Duration, listPods func(ctx context.Context) ([]PodCounters, error)) *Sampler {
+	return &Sampler{
+		client:     client,
+		nodeName:   nodeName,
+		window:     window,
+		listPods:   listPods,
+		readCounts: readRawCounters,
+	}
+}
+
+// Run samples counters and refreshes Pod annotations every window, until ctx
+// is cancelled.
+func (s *Sampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sampleOnce(ctx); err != nil {
+				klog.ErrorS(err, "pmu agent: sampling round failed", "node", s.nodeName)
+			}
+		}
+	}
+}
+
+func (s *Sampler) sampleOnce(ctx context.Context) error {
+	pods, err := s.listPods(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list Pods on node %q: %w", s.nodeName, err)
+	}
+	for _, pc := range pods {
+		raw, err := s.readCounts(pc, s.window)
+		if err != nil {
+			klog.ErrorS(err, "pmu agent: failed to read counters for Pod", "pod", pc.Name, "namespace", pc.Namespace)
+			continue
+		}
+		cv := raw.toContentionVector()
+		if err := s.publish(ctx, pc, cv); err != nil {
+			klog.ErrorS(err, "pmu agent: failed to publish ContentionVector", "pod", pc.Name, "namespace", pc.Namespace)
+		}
+	}
+	return nil
+}
+
+func (s *Sampler) publish(ctx context.Context, pc PodCounters, cv pmu.ContentionVector) error {
+	encoded, err := pmu.EncodeContentionVector(cv)
+	if err != nil {
+		return err
+	}
+	patch := []byte(fmt.Sprintf(
+		`{"metadata":{"annotations":{%q:%q}}}`,
+		pmu.ContentionVectorAnnotationKey, encoded,
+	))
+	_, err = s.client.CoreV1().Pods(pc.Namespace).Patch(ctx, pc.Name, patchTypeMergePatch, patch, patchOptions)
+	return err
+}
+
+// rawCounters holds the raw event counts accumulated over a sampling window,
+// before they are turned into a pmu.ContentionVector.
+type rawCounters struct {
+	cycles       uint64
+	instructions uint64
+	llcMisses    uint64
+	llcRefs      uint64
+	memBWMBps    float64
+}
+
+func (r rawCounters) toContentionVector() pmu.ContentionVector {
+	var ipc, mpki float64
+	if r.cycles > 0 {
+		ipc = float64(r.instructions) / float64(r.cycles)
+	}
+	if r.instructions > 0 {
+		mpki = float64(r.llcMisses) / (float64(r.instructions) / 1000.0)
+	}
+	return pmu.ContentionVector{
+		LLCMPKI:   mpki,
+		MemBWMBps: r.memBWMBps,
+		IPC:       ipc,
+	}
+}
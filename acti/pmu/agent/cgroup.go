@@ -0,0 +1,54 @@
+//go:build linux
+
+package agent
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var hostEndian = binary.LittleEndian
+
+// cgroupLeaderPID returns the first PID found in cgroup.procs under the
+// cgroupfs v2 mountpoint for the given cgroup path, used as the
+// representative PID to attach perf events to (inherited attributes follow
+// the whole thread group).
+func cgroupLeaderPID(cgroupPath string) (int, error) {
+	procsPath := filepath.Join("/sys/fs/cgroup", cgroupPath, "cgroup.procs")
+	data, err := os.ReadFile(procsPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %q: %w", procsPath, err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		pid, err := strconv.Atoi(line)
+		if err != nil {
+			continue
+		}
+		return pid, nil
+	}
+	return 0, fmt.Errorf("no PIDs found in cgroup %q", cgroupPath)
+}
+
+// podCgroupPath returns the cgroup path (relative to the cgroupfs
+// mountpoint) of the Pod's pause/sandbox cgroup, following the kubelet's
+// "kubepods" cgroup driver layout.
+func podCgroupPath(qos corev1.PodQOSClass, uid types.UID) string {
+	switch qos {
+	case corev1.PodQOSGuaranteed:
+		return filepath.Join("kubepods", fmt.Sprintf("pod%s", uid))
+	case corev1.PodQOSBurstable:
+		return filepath.Join("kubepods", "burstable", fmt.Sprintf("pod%s", uid))
+	default:
+		return filepath.Join("kubepods", "besteffort", fmt.Sprintf("pod%s", uid))
+	}
+}
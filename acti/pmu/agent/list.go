@@ -0,0 +1,38 @@
+//go:build linux
+
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ListLocalPods returns a listPods function, suitable for NewSampler, that
+// discovers the Pods scheduled onto nodeName via the API server and derives
+// their cgroup paths from their QoS class and UID, following the kubelet's
+// own cgroup driver convention (kubepods/<qos>/pod<uid>).
+func ListLocalPods(client kubernetes.Interface, nodeName string) func(ctx context.Context) ([]PodCounters, error) {
+	return func(ctx context.Context) ([]PodCounters, error) {
+		pods, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Pods on node %q: %w", nodeName, err)
+		}
+
+		out := make([]PodCounters, 0, len(pods.Items))
+		for _, pod := range pods.Items {
+			out = append(out, PodCounters{
+				Namespace:  pod.Namespace,
+				Name:       pod.Name,
+				UID:        pod.UID,
+				CgroupPath: podCgroupPath(pod.Status.QOSClass, pod.UID),
+			})
+		}
+		return out, nil
+	}
+}
@@ -0,0 +1,62 @@
+//go:build linux
+
+package agent
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// resctrlMountpoint is where the resctrl pseudo-filesystem is expected to be
+// mounted, exposing Intel RDT's Memory Bandwidth Monitoring (MBM) counters.
+const resctrlMountpoint = "/sys/fs/resctrl"
+
+// readMBMTotalMBps reads the MBM_TOTAL_BYTES RDT counter for the resctrl
+// monitoring group corresponding to cgroupPath, twice, window apart, and
+// returns the average bandwidth observed in MB/s. It returns 0 without error
+// when resctrl (or RDT/MBM support) is unavailable on this node, so that
+// callers can still produce a ContentionVector with the other dimensions
+// populated.
+func readMBMTotalMBps(cgroupPath string, window time.Duration) float64 {
+	path := filepath.Join(resctrlMountpoint, "mon_groups", sanitizeGroupName(cgroupPath), "mon_data", "mon_L3_00", "mbm_total_bytes")
+
+	before, ok := readUintFile(path)
+	if !ok {
+		return 0
+	}
+	time.Sleep(window)
+	after, ok := readUintFile(path)
+	if !ok {
+		return 0
+	}
+	if after < before {
+		// Counter wrapped or the monitoring group was recreated mid-window.
+		return 0
+	}
+
+	deltaBytes := after - before
+	mb := float64(deltaBytes) / (1024 * 1024)
+	return mb / window.Seconds()
+}
+
+func sanitizeGroupName(cgroupPath string) string {
+	return strings.ReplaceAll(strings.Trim(cgroupPath, "/"), "/", "-")
+}
+
+func readUintFile(path string) (uint64, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		klog.V(4).InfoS("pmu agent: MBM counter unavailable", "path", path, "err", err)
+		return 0, false
+	}
+	v, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
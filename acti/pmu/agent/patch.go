@@ -0,0 +1,12 @@
+//go:build linux
+
+package agent
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const patchTypeMergePatch = types.MergePatchType
+
+var patchOptions = metav1.PatchOptions{FieldManager: "acti-pmu-agent"}
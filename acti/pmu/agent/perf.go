@@ -0,0 +1,118 @@
+//go:build linux
+
+package agent
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// perfCounter is a single open perf_event_open file descriptor for one of
+// the hardware events we sample.
+type perfCounter struct {
+	fd    int
+	event string
+}
+
+// openPerfCounters opens the set of hardware counters (cycles, instructions,
+// LLC misses and LLC references) for the given PID, grouped so they are
+// read consistently. Callers must call closePerfCounters when done.
+func openPerfCounters(pid int) ([]perfCounter, error) {
+	specs := []struct {
+		name   string
+		typ    uint32
+		config uint64
+	}{
+		{"cycles", unix.PERF_TYPE_HARDWARE, unix.PERF_COUNT_HW_CPU_CYCLES},
+		{"instructions", unix.PERF_TYPE_HARDWARE, unix.PERF_COUNT_HW_INSTRUCTIONS},
+		{"llc_misses", unix.PERF_TYPE_HARDWARE, unix.PERF_COUNT_HW_CACHE_MISSES},
+		{"llc_refs", unix.PERF_TYPE_HARDWARE, unix.PERF_COUNT_HW_CACHE_REFERENCES},
+	}
+
+	counters := make([]perfCounter, 0, len(specs))
+	for _, spec := range specs {
+		attr := &unix.PerfEventAttr{
+			Type:   spec.typ,
+			Config: spec.config,
+			Size:   uint32(unix.SizeofPerfEventAttr),
+			Bits:   unix.PerfBitDisabled | unix.PerfBitInherit,
+		}
+		fd, err := unix.PerfEventOpen(attr, pid, -1, -1, unix.PERF_FLAG_FD_CLOEXEC)
+		if err != nil {
+			closePerfCounters(counters)
+			return nil, fmt.Errorf("perf_event_open(%s, pid=%d): %w", spec.name, pid, err)
+		}
+		counters = append(counters, perfCounter{fd: fd, event: spec.name})
+	}
+	return counters, nil
+}
+
+func closePerfCounters(counters []perfCounter) {
+	for _, c := range counters {
+		_ = unix.Close(c.fd)
+	}
+}
+
+// sampleWindow reads all of the given counters' deltas over the given
+// duration. The PIDs are expected to have already been enabled by the
+// caller via unix.IoctlSetInt(fd, unix.PERF_EVENT_IOC_ENABLE, 0).
+func sampleWindow(counters []perfCounter, window time.Duration) (map[string]uint64, error) {
+	for _, c := range counters {
+		if err := unix.IoctlSetInt(c.fd, unix.PERF_EVENT_IOC_RESET, 0); err != nil {
+			return nil, fmt.Errorf("failed to reset counter %q: %w", c.event, err)
+		}
+		if err := unix.IoctlSetInt(c.fd, unix.PERF_EVENT_IOC_ENABLE, 0); err != nil {
+			return nil, fmt.Errorf("failed to enable counter %q: %w", c.event, err)
+		}
+	}
+	time.Sleep(window)
+
+	counts := make(map[string]uint64, len(counters))
+	buf := make([]byte, 8)
+	for _, c := range counters {
+		if err := unix.IoctlSetInt(c.fd, unix.PERF_EVENT_IOC_DISABLE, 0); err != nil {
+			return nil, fmt.Errorf("failed to disable counter %q: %w", c.event, err)
+		}
+		n, err := unix.Read(c.fd, buf)
+		if err != nil || n != len(buf) {
+			return nil, fmt.Errorf("failed to read counter %q: %w", c.event, err)
+		}
+		counts[c.event] = hostEndian.Uint64(buf)
+	}
+	return counts, nil
+}
+
+// readRawCounters samples the hardware counters for the PID backing pc over
+// window, combining them with the concurrent memory-bandwidth reading from
+// Intel RDT's MBM counters (best-effort; zero when RDT/resctrl is
+// unavailable on this node).
+func readRawCounters(pc PodCounters, window time.Duration) (rawCounters, error) {
+	pid, err := cgroupLeaderPID(pc.CgroupPath)
+	if err != nil {
+		return rawCounters{}, err
+	}
+
+	counters, err := openPerfCounters(pid)
+	if err != nil {
+		return rawCounters{}, err
+	}
+	defer closePerfCounters(counters)
+
+	memBWDone := make(chan float64, 1)
+	go func() { memBWDone <- readMBMTotalMBps(pc.CgroupPath, window) }()
+
+	counts, err := sampleWindow(counters, window)
+	if err != nil {
+		return rawCounters{}, err
+	}
+
+	return rawCounters{
+		cycles:       counts["cycles"],
+		instructions: counts["instructions"],
+		llcMisses:    counts["llc_misses"],
+		llcRefs:      counts["llc_refs"],
+		memBWMBps:    <-memBWDone,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package pmu
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/ckatsak/actischedulertest01/acti/hardcoded"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+//
+// Coefficients
+//
+///////////////////////////////////////////////////////////////////////////////
+
+// Coefficients holds the regression coefficients used by PMUModel to turn a
+// pair of ContentionVectors into a slowdown estimate. They are expected to be
+// loaded from a ConfigMap by the caller and passed to New.
+type Coefficients struct {
+	// Alpha weighs the contribution of combined memory-bandwidth
+	// pressure, relative to the node's memory-bandwidth capacity.
+	Alpha float64
+	// Beta weighs the contribution of the product of the two Pods' LLC
+	// misses per kilo instruction.
+	Beta float64
+	// NodeMBWCapMBps is the memory-bandwidth capacity of the node, in
+	// MB/s, used to normalize the combined memory-bandwidth pressure.
+	NodeMBWCapMBps float64
+}
+
+///////////////////////////////////////////////////////////////////////////////
+//
+// Model
+//
+///////////////////////////////////////////////////////////////////////////////
+
+// Model is an implementation of acti.InterferenceModel that derives slowdown
+// estimates at runtime from hardware performance counters sampled by a
+// per-node agent (see the agent subpackage), rather than from a static
+// matrix. When no sample is yet available for one of the two Pods involved,
+// Attack falls back to a static, hardcoded.HardcodedSlowDowns estimate.
+type Model struct {
+	actiLabelKey string
+	coeffs       Coefficients
+	fallback     *hardcoded.HardcodedSlowDowns
+}
+
+// New returns a new Model with the given label key (the one that is used by
+// ActiPlugin to track its applications), regression Coefficients, and
+// fallback model used whenever the agent has not yet published a
+// ContentionVector for one of the Pods under consideration.
+func New(actiLabelKey string, coeffs Coefficients, fallback *hardcoded.HardcodedSlowDowns) *Model {
+	return &Model{
+		actiLabelKey: actiLabelKey,
+		coeffs:       coeffs,
+		fallback:     fallback,
+	}
+}
+
+// Attack implements acti.InterferenceModel; see the documentation there for
+// more information.
+//
+// The estimate is computed as:
+//
+//	slowdown = 1 + alpha*min(attackerMBW+occupantMBW, nodeMBWCap)/nodeMBWCap
+//	             + beta*(attackerMPKI*occupantMPKI)
+//
+// using the most recently observed ContentionVector of each of the two Pods.
+// If either Pod has no ContentionVector yet (e.g. the agent has not sampled
+// it long enough), Attack falls back to m.fallback.
+func (m *Model) Attack(attacker, occupant *corev1.Pod) (float64, error) {
+	attackerCV, ok := ReadContentionVector(attacker)
+	if !ok {
+		return m.fallback.Attack(attacker, occupant)
+	}
+	occupantCV, ok := ReadContentionVector(occupant)
+	if !ok {
+		return m.fallback.Attack(attacker, occupant)
+	}
+
+	if m.coeffs.NodeMBWCapMBps <= 0 {
+		return -1, fmt.Errorf("pmu: invalid NodeMBWCapMBps coefficient: %f", m.coeffs.NodeMBWCapMBps)
+	}
+	combinedMBW := attackerCV.MemBWMBps + occupantCV.MemBWMBps
+	if combinedMBW > m.coeffs.NodeMBWCapMBps {
+		combinedMBW = m.coeffs.NodeMBWCapMBps
+	}
+
+	slowdown := 1 +
+		m.coeffs.Alpha*combinedMBW/m.coeffs.NodeMBWCapMBps +
+		m.coeffs.Beta*(attackerCV.LLCMPKI*occupantCV.LLCMPKI)
+	return slowdown, nil
+}
+
+// ToInt64Multiplier implements acti.InterferenceModel; see the documentation
+// there for more information. It defers to the fallback model so that scores
+// produced by both models remain comparable.
+func (m *Model) ToInt64Multiplier() float64 {
+	return m.fallback.ToInt64Multiplier()
+}
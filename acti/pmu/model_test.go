@@ -0,0 +1,113 @@
+package pmu
+
+import (
+	"math"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ckatsak/actischedulertest01/acti/hardcoded"
+)
+
+const testLabelKey = "acti.ckatsak.io/category"
+
+// podWithVector builds a Pod carrying the given appCategory label and
+// ContentionVector annotation, as the agent would have left it on a
+// previously-scheduled Pod.
+func podWithVector(name, category string, cv *ContentionVector) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{testLabelKey: category},
+		},
+	}
+	if cv != nil {
+		encoded, err := EncodeContentionVector(*cv)
+		if err != nil {
+			panic(err)
+		}
+		pod.Annotations = map[string]string{ContentionVectorAnnotationKey: encoded}
+	}
+	return pod
+}
+
+// recorded counter fixtures, as if sampled off real hardware by the agent.
+var (
+	fixtureLowContention = ContentionVector{LLCMPKI: 0.8, MemBWMBps: 1200, IPC: 1.9}
+	fixtureHighMemBW     = ContentionVector{LLCMPKI: 1.1, MemBWMBps: 9000, IPC: 1.2}
+	fixtureHighLLC       = ContentionVector{LLCMPKI: 6.4, MemBWMBps: 2000, IPC: 0.6}
+)
+
+func TestModelAttackWithSamples(t *testing.T) {
+	m := New(testLabelKey, Coefficients{Alpha: 0.5, Beta: 0.1, NodeMBWCapMBps: 10000}, hardcoded.New(testLabelKey))
+
+	attacker := podWithVector("attacker", "catA", &fixtureHighMemBW)
+	occupant := podWithVector("occupant", "catB", &fixtureLowContention)
+
+	got, err := m.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("Attack returned unexpected error: %v", err)
+	}
+
+	combinedMBW := fixtureHighMemBW.MemBWMBps + fixtureLowContention.MemBWMBps
+	want := 1 + 0.5*combinedMBW/10000 + 0.1*(fixtureHighMemBW.LLCMPKI*fixtureLowContention.LLCMPKI)
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("Attack() = %f, want %f", got, want)
+	}
+}
+
+func TestModelAttackClampsMemBWToNodeCap(t *testing.T) {
+	m := New(testLabelKey, Coefficients{Alpha: 1, Beta: 0, NodeMBWCapMBps: 1000}, hardcoded.New(testLabelKey))
+
+	attacker := podWithVector("attacker", "catA", &fixtureHighMemBW) // 9000 MB/s alone
+	occupant := podWithVector("occupant", "catB", &fixtureHighMemBW)
+
+	got, err := m.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("Attack returned unexpected error: %v", err)
+	}
+	if want := 2.0; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Attack() = %f, want %f (combined MBW should clamp to NodeMBWCapMBps)", got, want)
+	}
+}
+
+func TestModelAttackFallsBackWithoutSamples(t *testing.T) {
+	m := New(testLabelKey, Coefficients{Alpha: 0.5, Beta: 0.1, NodeMBWCapMBps: 10000}, hardcoded.New(testLabelKey))
+
+	attacker := podWithVector("attacker", "catA", nil) // agent hasn't sampled this one yet
+	occupant := podWithVector("occupant", "catB", &fixtureHighLLC)
+
+	got, err := m.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("Attack returned unexpected error: %v", err)
+	}
+
+	fallback := hardcoded.New(testLabelKey)
+	want, err := fallback.Attack(attacker, occupant)
+	if err != nil {
+		t.Fatalf("fallback.Attack returned unexpected error: %v", err)
+	}
+	if got != want {
+		t.Errorf("Attack() = %f, want fallback estimate %f", got, want)
+	}
+}
+
+func TestModelAttackRejectsUnknownCategory(t *testing.T) {
+	m := New(testLabelKey, Coefficients{Alpha: 0.5, Beta: 0.1, NodeMBWCapMBps: 10000}, hardcoded.New(testLabelKey))
+
+	attacker := podWithVector("attacker", "catZZZ", nil)
+	occupant := podWithVector("occupant", "catB", &fixtureLowContention)
+
+	if _, err := m.Attack(attacker, occupant); err == nil {
+		t.Fatal("Attack() expected an error for an unknown appCategory, got nil")
+	}
+}
+
+func TestModelToInt64Multiplier(t *testing.T) {
+	fallback := hardcoded.New(testLabelKey)
+	m := New(testLabelKey, Coefficients{}, fallback)
+	if got, want := m.ToInt64Multiplier(), fallback.ToInt64Multiplier(); got != want {
+		t.Errorf("ToInt64Multiplier() = %f, want %f (should match fallback)", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+// Package pmu contains an implementation of acti.InterferenceModel, where
+// slowdown estimates are derived at runtime from hardware performance
+// counters (PMU events) sampled by a per-node agent, rather than from a
+// static matrix.
+package pmu
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ContentionVectorAnnotationKey is the Pod annotation under which the
+// per-node agent publishes the most recently observed ContentionVector for
+// that Pod, JSON-encoded.
+const ContentionVectorAnnotationKey = "pmu.acti.ckatsak.io/contention-vector"
+
+// ContentionVector is the contention profile of a single Pod, sampled by the
+// agent over a rolling window of hardware performance counters.
+type ContentionVector struct {
+	// LLCMPKI is the number of last-level-cache misses per kilo
+	// instruction observed for the Pod.
+	LLCMPKI float64 `json:"llcMpki"`
+	// MemBWMBps is the memory bandwidth consumed by the Pod, in MB/s, as
+	// reported by Intel RDT's MBM_TOTAL/MBM_LOCAL counters when
+	// available.
+	MemBWMBps float64 `json:"memBWMBps"`
+	// IPC is the instructions-per-cycle observed for the Pod.
+	IPC float64 `json:"ipc"`
+}
+
+// ReadContentionVector extracts and decodes the ContentionVector published by
+// the agent on the given Pod's annotations. The second return value is false
+// when the Pod carries no (or an unparseable) annotation, in which case the
+// caller should fall back to a static estimate.
+func ReadContentionVector(pod *corev1.Pod) (ContentionVector, bool) {
+	raw, ok := pod.Annotations[ContentionVectorAnnotationKey]
+	if !ok || raw == "" {
+		return ContentionVector{}, false
+	}
+	var cv ContentionVector
+	if err := json.Unmarshal([]byte(raw), &cv); err != nil {
+		return ContentionVector{}, false
+	}
+	return cv, true
+}
+
+// EncodeContentionVector is the inverse of ReadContentionVector, used by the
+// agent to populate ContentionVectorAnnotationKey.
+func EncodeContentionVector(cv ContentionVector) (string, error) {
+	b, err := json.Marshal(cv)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ContentionVector: %w", err)
+	}
+	return string(b), nil
+}
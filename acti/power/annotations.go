@@ -0,0 +1,98 @@
+// Package power implements a MultiObjectiveModel adapter that fills in the
+// PowerDeltaWatts dimension of an ObjectiveVector from Node annotations
+// written by an out-of-tree power-monitoring agent (e.g. Kepler), leaving
+// every other dimension to a wrapped MultiObjectiveModel.
+package power
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/ckatsak/actischedulertest01/acti/objective"
+)
+
+// hotNodeWattsThreshold is the current draw, in Watts, above which a node is
+// considered to already be running hot: colocating another Pod there is
+// predicted to cost more (thermal throttling makes contention worse) than
+// CategoryWatts alone would suggest.
+const hotNodeWattsThreshold = 300.0
+
+// hotNodePowerPressureMultiplier scales CategoryWatts' baseline estimate up
+// when the occupant node is already running hot, per hotNodeWattsThreshold.
+const hotNodePowerPressureMultiplier = 1.5
+
+// NodeWattsAnnotationKey is the Node annotation under which the power agent
+// publishes the node's current total power draw, in Watts.
+const NodeWattsAnnotationKey = "power.acti.ckatsak.io/current-watts"
+
+// CategoryWatts maps an application category to its average power draw, in
+// Watts, as observed offline (e.g. by correlating NodeWattsAnnotationKey
+// samples with which categories were running). It is loaded the same way
+// hardcoded.HardcodedSlowDowns' matrix is: as a static table supplied by the
+// caller.
+type CategoryWatts map[string]float64
+
+// AnnotationAdapter wraps a MultiObjectiveModel, overriding its
+// PowerDeltaWatts dimension with an estimate derived from CategoryWatts,
+// while deferring every other dimension to Base.
+type AnnotationAdapter struct {
+	Base          objective.MultiObjectiveModel
+	ActiLabelKey  string
+	CategoryWatts CategoryWatts
+	NodeLister    corelisters.NodeLister
+}
+
+// AttackVector implements objective.MultiObjectiveModel. PowerDeltaWatts is
+// estimated as attacker's average CategoryWatts draw, scaled up by
+// hotNodePowerPressureMultiplier when the occupant node's current total
+// draw, published via NodeWattsAnnotationKey, is already at or above
+// hotNodeWattsThreshold (colocating onto an already-hot node is predicted to
+// cost more than the baseline CategoryWatts estimate).
+func (a AnnotationAdapter) AttackVector(attacker, occupant *corev1.Pod) (objective.ObjectiveVector, error) {
+	vector, err := a.Base.AttackVector(attacker, occupant)
+	if err != nil {
+		return objective.ObjectiveVector{}, err
+	}
+
+	attackerCategory := attacker.Labels[a.ActiLabelKey]
+	delta, ok := a.CategoryWatts[attackerCategory]
+	if !ok {
+		return objective.ObjectiveVector{}, fmt.Errorf("power: no CategoryWatts entry for category %q", attackerCategory)
+	}
+
+	if watts, ok := a.currentNodeWatts(occupant.Spec.NodeName); ok && watts >= hotNodeWattsThreshold {
+		klog.V(2).InfoS("power: node already running hot, scaling up predicted power delta", "node", occupant.Spec.NodeName, "currentWatts", watts)
+		delta *= hotNodePowerPressureMultiplier
+	}
+	vector.PowerDeltaWatts = delta
+
+	return vector, nil
+}
+
+// ToInt64Multiplier implements objective.MultiObjectiveModel.
+func (a AnnotationAdapter) ToInt64Multiplier() float64 {
+	return a.Base.ToInt64Multiplier()
+}
+
+// currentNodeWatts reads the node's current total power draw, as published
+// by the power agent on occupant's node. It returns false when the
+// annotation is absent or unparseable.
+func (a AnnotationAdapter) currentNodeWatts(nodeName string) (float64, bool) {
+	node, err := a.NodeLister.Get(nodeName)
+	if err != nil {
+		return 0, false
+	}
+	raw, ok := node.Annotations[NodeWattsAnnotationKey]
+	if !ok {
+		return 0, false
+	}
+	watts, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false
+	}
+	return watts, true
+}
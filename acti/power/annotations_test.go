@@ -0,0 +1,106 @@
+package power
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/ckatsak/actischedulertest01/acti/objective"
+)
+
+const testLabelKey = "acti.ckatsak.io/category"
+
+type fakeBase struct{}
+
+func (fakeBase) AttackVector(_, _ *corev1.Pod) (objective.ObjectiveVector, error) {
+	return objective.ObjectiveVector{Slowdown: 1.5}, nil
+}
+
+func (fakeBase) ToInt64Multiplier() float64 { return 100 }
+
+func newTestNodeLister(t *testing.T, nodes ...*corev1.Node) corelisters.NodeLister {
+	t.Helper()
+	client := fake.NewSimpleClientset()
+	for _, n := range nodes {
+		if _, err := client.CoreV1().Nodes().Create(context.Background(), n, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("failed to create test node: %v", err)
+		}
+	}
+	factory := informers.NewSharedInformerFactory(client, 0)
+	lister := factory.Core().V1().Nodes().Lister()
+	factory.Start(nil)
+	factory.WaitForCacheSync(nil)
+	return lister
+}
+
+func TestAnnotationAdapterFillsPowerDelta(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	adapter := AnnotationAdapter{
+		Base:          fakeBase{},
+		ActiLabelKey:  testLabelKey,
+		CategoryWatts: CategoryWatts{"catA": 42.0},
+		NodeLister:    newTestNodeLister(t, node),
+	}
+
+	attacker := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "attacker", Labels: map[string]string{testLabelKey: "catA"}}}
+	occupant := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "occupant"}, Spec: corev1.PodSpec{NodeName: "node1"}}
+
+	v, err := adapter.AttackVector(attacker, occupant)
+	if err != nil {
+		t.Fatalf("AttackVector returned unexpected error: %v", err)
+	}
+	if v.Slowdown != 1.5 {
+		t.Errorf("AttackVector().Slowdown = %f, want 1.5 (from Base)", v.Slowdown)
+	}
+	if v.PowerDeltaWatts != 42.0 {
+		t.Errorf("AttackVector().PowerDeltaWatts = %f, want 42.0", v.PowerDeltaWatts)
+	}
+}
+
+func TestAnnotationAdapterScalesUpPowerDeltaOnHotNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "node1",
+			Annotations: map[string]string{NodeWattsAnnotationKey: "350"},
+		},
+	}
+	adapter := AnnotationAdapter{
+		Base:          fakeBase{},
+		ActiLabelKey:  testLabelKey,
+		CategoryWatts: CategoryWatts{"catA": 42.0},
+		NodeLister:    newTestNodeLister(t, node),
+	}
+
+	attacker := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "attacker", Labels: map[string]string{testLabelKey: "catA"}}}
+	occupant := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "occupant"}, Spec: corev1.PodSpec{NodeName: "node1"}}
+
+	v, err := adapter.AttackVector(attacker, occupant)
+	if err != nil {
+		t.Fatalf("AttackVector returned unexpected error: %v", err)
+	}
+	want := 42.0 * hotNodePowerPressureMultiplier
+	if v.PowerDeltaWatts != want {
+		t.Errorf("AttackVector().PowerDeltaWatts = %f, want %f (scaled up for a hot node)", v.PowerDeltaWatts, want)
+	}
+}
+
+func TestAnnotationAdapterErrorsOnUnknownCategory(t *testing.T) {
+	adapter := AnnotationAdapter{
+		Base:          fakeBase{},
+		ActiLabelKey:  testLabelKey,
+		CategoryWatts: CategoryWatts{},
+		NodeLister:    newTestNodeLister(t),
+	}
+
+	attacker := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "attacker", Labels: map[string]string{testLabelKey: "catZZZ"}}}
+	occupant := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "occupant"}}
+
+	if _, err := adapter.AttackVector(attacker, occupant); err == nil {
+		t.Fatal("AttackVector() expected an error for an unknown category, got nil")
+	}
+}
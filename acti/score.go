@@ -0,0 +1,203 @@
+package acti
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+)
+
+// scoreStateKey is the framework.CycleState key under which scoreState is
+// stashed by Score, for NormalizeScore to pick back up.
+const scoreStateKey = "acti.ckatsak.io/score-state"
+
+// scoreState carries the raw, per-node ObjectiveVectors computed by Score
+// across to NormalizeScore, which needs every candidate node's vector at
+// once to min-max normalize each dimension.
+type scoreState struct {
+	vectors map[string]ObjectiveVector // keyed by node name
+}
+
+// Clone implements framework.StateData.
+func (s *scoreState) Clone() framework.StateData {
+	clone := &scoreState{vectors: make(map[string]ObjectiveVector, len(s.vectors))}
+	for node, v := range s.vectors {
+		clone.vectors[node] = v
+	}
+	return clone
+}
+
+// ScoreWithModel implements the Score extension point for a given
+// MultiObjectiveModel: it computes pod's aggregate ObjectiveVector against
+// every co-tenant already on nodeName and stashes it on state for
+// NormalizeScoreWithWeights to combine. The int64 it returns directly is not
+// meaningful on its own; NormalizeScoreWithWeights overwrites every node's
+// Score once all of them have been computed.
+func ScoreWithModel(
+	ctx context.Context,
+	model MultiObjectiveModel,
+	state *framework.CycleState,
+	pod *corev1.Pod,
+	nodeName string,
+	coTenants []*corev1.Pod,
+) (int64, *framework.Status) {
+	var aggregate ObjectiveVector
+	for _, occupant := range coTenants {
+		v, err := model.AttackVector(pod, occupant)
+		if err != nil {
+			return 0, framework.AsStatus(fmt.Errorf("acti: failed to compute ObjectiveVector on node %q: %w", nodeName, err))
+		}
+		aggregate.Slowdown += v.Slowdown
+		aggregate.PowerDeltaWatts += v.PowerDeltaWatts
+		aggregate.MemBWContentionMBps += v.MemBWContentionMBps
+		aggregate.LLCPressure += v.LLCPressure
+	}
+
+	// CycleState provides no data protection of its own: Read/Write on
+	// the same key are unsynchronized, and the framework calls Score
+	// concurrently across candidate nodes for the same Pod. Hold state's
+	// own Lock for the whole get-or-init-then-mutate sequence so two
+	// nodes scored at once can't each initialize their own scoreState
+	// (the second Write silently orphaning the first one's vectors) or
+	// race on the shared vectors map itself.
+	state.Lock()
+	defer state.Unlock()
+	data, err := readOrInitScoreStateLocked(state)
+	if err != nil {
+		return 0, framework.AsStatus(err)
+	}
+	data.vectors[nodeName] = aggregate
+	return 0, nil
+}
+
+// readOrInitScoreStateLocked returns the scoreState stashed on state,
+// initializing one on first use. Callers must hold state.Lock().
+func readOrInitScoreStateLocked(state *framework.CycleState) (*scoreState, error) {
+	existing, err := state.Read(scoreStateKey)
+	if err == nil {
+		data, ok := existing.(*scoreState)
+		if !ok {
+			return nil, fmt.Errorf("acti: unexpected type %T for scoreState", existing)
+		}
+		return data, nil
+	}
+	data := &scoreState{vectors: make(map[string]ObjectiveVector)}
+	state.Write(scoreStateKey, data)
+	return data, nil
+}
+
+// NormalizeScoreWithWeights implements the NormalizeScore extension point:
+// it min-max normalizes each ObjectiveVector dimension across every node in
+// scores, combines them via weights, and scales the result to
+// [0, framework.MaxNodeScore].
+func NormalizeScoreWithWeights(
+	_ context.Context,
+	state *framework.CycleState,
+	_ *corev1.Pod,
+	weights configv1alpha1.ScoreWeights,
+	scores framework.NodeScoreList,
+) *framework.Status {
+	// Score may still be running for other nodes on other goroutines when
+	// NormalizeScore starts (the framework only barriers between
+	// Score-for-all-nodes and NormalizeScore, not within Score itself in
+	// every version), so read data.vectors under state's RLock too.
+	state.RLock()
+	existing, err := state.Read(scoreStateKey)
+	if err != nil {
+		state.RUnlock()
+		return framework.AsStatus(fmt.Errorf("acti: no scoreState found for NormalizeScore: %w", err))
+	}
+	data, ok := existing.(*scoreState)
+	if !ok {
+		state.RUnlock()
+		return framework.AsStatus(fmt.Errorf("acti: unexpected type %T for scoreState", existing))
+	}
+
+	slowdowns := make([]float64, 0, len(scores))
+	powers := make([]float64, 0, len(scores))
+	mems := make([]float64, 0, len(scores))
+	llcs := make([]float64, 0, len(scores))
+	for _, ns := range scores {
+		v := data.vectors[ns.Name]
+		slowdowns = append(slowdowns, v.Slowdown)
+		powers = append(powers, v.PowerDeltaWatts)
+		mems = append(mems, v.MemBWContentionMBps)
+		llcs = append(llcs, v.LLCPressure)
+	}
+	state.RUnlock()
+
+	normSlowdown := minMaxNormalize(slowdowns)
+	normPower := minMaxNormalize(powers)
+	normMem := minMaxNormalize(mems)
+	normLLC := minMaxNormalize(llcs)
+
+	for i := range scores {
+		combined := weights.WSlow*normSlowdown[i] +
+			weights.WPower*normPower[i] +
+			weights.WMem*normMem[i] +
+			weights.WLLC*normLLC[i]
+		// Every dimension is a cost (lower is better), so a node with
+		// combined == 0 (the best across the candidate set) should
+		// score framework.MaxNodeScore, and combined == 1 should
+		// score 0. Weights aren't required to sum to 1 (callers may
+		// pass e.g. {WSlow: 0.8, WPower: 0.5}), so clamp combined
+		// before scaling to guarantee the result never leaves
+		// [MinNodeScore, MaxNodeScore].
+		combined = math.Min(1, math.Max(0, combined))
+		scores[i].Score = int64(math.Round(float64(framework.MaxNodeScore) * (1 - combined)))
+	}
+	return nil
+}
+
+// SlowdownsFromState extracts the per-node aggregate Slowdown dimension
+// stashed away by ScoreWithModel, for callers (e.g. Plugin's metrics and
+// event wiring) that want to report the same predicted values elsewhere
+// without recomputing them.
+func SlowdownsFromState(state *framework.CycleState) (map[string]float64, error) {
+	state.RLock()
+	defer state.RUnlock()
+	existing, err := state.Read(scoreStateKey)
+	if err != nil {
+		return nil, fmt.Errorf("acti: no scoreState found: %w", err)
+	}
+	data, ok := existing.(*scoreState)
+	if !ok {
+		return nil, fmt.Errorf("acti: unexpected type %T for scoreState", existing)
+	}
+	out := make(map[string]float64, len(data.vectors))
+	for node, v := range data.vectors {
+		out[node] = v.Slowdown
+	}
+	return out, nil
+}
+
+// minMaxNormalize scales values to [0, 1], where the minimum value maps to 0
+// and the maximum to 1. When every value is equal (including the
+// single-candidate case), it returns all zeros, since there is nothing to
+// differentiate nodes on that dimension.
+func minMaxNormalize(values []float64) []float64 {
+	out := make([]float64, len(values))
+	if len(values) == 0 {
+		return out
+	}
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	if max == min {
+		return out
+	}
+	for i, v := range values {
+		out[i] = (v - min) / (max - min)
+	}
+	return out
+}
@@ -0,0 +1,193 @@
+package acti
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+)
+
+const testLabelKey = "acti.ckatsak.io/category"
+
+func newPod(name, category string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{testLabelKey: category}}}
+}
+
+// occupantVectorModel is a MultiObjectiveModel test double that returns a
+// fixed ObjectiveVector keyed by the occupant Pod's name, letting tests
+// control exactly what each node's co-tenant contributes.
+type occupantVectorModel struct {
+	byOccupant map[string]ObjectiveVector
+}
+
+func (m occupantVectorModel) AttackVector(_, occupant *corev1.Pod) (ObjectiveVector, error) {
+	return m.byOccupant[occupant.Name], nil
+}
+
+func (m occupantVectorModel) ToInt64Multiplier() float64 { return 100 }
+
+func scoreAllNodes(t *testing.T, model MultiObjectiveModel, pod *corev1.Pod, nodes []string, coTenants map[string][]*corev1.Pod) (*framework.CycleState, framework.NodeScoreList) {
+	t.Helper()
+	state := framework.NewCycleState()
+	scores := make(framework.NodeScoreList, 0, len(nodes))
+	for _, node := range nodes {
+		if _, status := ScoreWithModel(context.Background(), model, state, pod, node, coTenants[node]); !status.IsSuccess() {
+			t.Fatalf("ScoreWithModel(%q) failed: %v", node, status)
+		}
+		scores = append(scores, framework.NodeScore{Name: node})
+	}
+	return state, scores
+}
+
+func scoreOf(scores framework.NodeScoreList, name string) int64 {
+	for _, s := range scores {
+		if s.Name == name {
+			return s.Score
+		}
+	}
+	return -1
+}
+
+func bestNode(scores framework.NodeScoreList) string {
+	best := scores[0]
+	for _, s := range scores[1:] {
+		if s.Score > best.Score {
+			best = s
+		}
+	}
+	return best.Name
+}
+
+func TestNormalizeScoreFavorsLowerCombinedCost(t *testing.T) {
+	pod := newPod("pod", "catA")
+	model := occupantVectorModel{byOccupant: map[string]ObjectiveVector{
+		"occ1": {Slowdown: 1.1},
+		"occ2": {Slowdown: 3.0},
+	}}
+	coTenants := map[string][]*corev1.Pod{
+		"node1": {newPod("occ1", "catB")},
+		"node2": {newPod("occ2", "catB")},
+	}
+
+	state, scores := scoreAllNodes(t, model, pod, []string{"node1", "node2"}, coTenants)
+	status := NormalizeScoreWithWeights(context.Background(), state, pod, configv1alpha1.ScoreWeights{WSlow: 1}, scores)
+	if !status.IsSuccess() {
+		t.Fatalf("NormalizeScoreWithWeights failed: %v", status)
+	}
+
+	if n1, n2 := scoreOf(scores, "node1"), scoreOf(scores, "node2"); n1 <= n2 {
+		t.Errorf("expected node1 (lower slowdown) to score higher than node2, got node1=%d node2=%d", n1, n2)
+	}
+}
+
+func TestNormalizeScoreWeightChangeFlipsChosenNode(t *testing.T) {
+	pod := newPod("pod", "catA")
+	// node1 is cheap on slowdown but expensive on power; node2 is the
+	// reverse.
+	model := occupantVectorModel{byOccupant: map[string]ObjectiveVector{
+		"occ1": {Slowdown: 1.0, PowerDeltaWatts: 100},
+		"occ2": {Slowdown: 3.0, PowerDeltaWatts: 0},
+	}}
+	coTenants := map[string][]*corev1.Pod{
+		"node1": {newPod("occ1", "catB")},
+		"node2": {newPod("occ2", "catB")},
+	}
+
+	slowState, slowScores := scoreAllNodes(t, model, pod, []string{"node1", "node2"}, coTenants)
+	if status := NormalizeScoreWithWeights(context.Background(), slowState, pod,
+		configv1alpha1.ScoreWeights{WSlow: 1}, slowScores); !status.IsSuccess() {
+		t.Fatalf("NormalizeScoreWithWeights failed: %v", status)
+	}
+	winnerBySlowdown := bestNode(slowScores)
+
+	powerState, powerScores := scoreAllNodes(t, model, pod, []string{"node1", "node2"}, coTenants)
+	if status := NormalizeScoreWithWeights(context.Background(), powerState, pod,
+		configv1alpha1.ScoreWeights{WPower: 1}, powerScores); !status.IsSuccess() {
+		t.Fatalf("NormalizeScoreWithWeights failed: %v", status)
+	}
+	winnerByPower := bestNode(powerScores)
+
+	if winnerBySlowdown == winnerByPower {
+		t.Fatalf("expected changing weights to flip the chosen node, got %q both times", winnerBySlowdown)
+	}
+}
+
+func TestNormalizeScoreClampsWhenWeightsSumAboveOne(t *testing.T) {
+	pod := newPod("pod", "catA")
+	model := occupantVectorModel{byOccupant: map[string]ObjectiveVector{
+		"occ1": {Slowdown: 1.0, PowerDeltaWatts: 100},
+		"occ2": {Slowdown: 3.0, PowerDeltaWatts: 0},
+	}}
+	coTenants := map[string][]*corev1.Pod{
+		"node1": {newPod("occ1", "catB")},
+		"node2": {newPod("occ2", "catB")},
+	}
+
+	state, scores := scoreAllNodes(t, model, pod, []string{"node1", "node2"}, coTenants)
+	// WSlow + WPower = 1.3, so an unclamped combined cost could exceed 1
+	// and drive Score below 0.
+	status := NormalizeScoreWithWeights(context.Background(), state, pod,
+		configv1alpha1.ScoreWeights{WSlow: 0.8, WPower: 0.5}, scores)
+	if !status.IsSuccess() {
+		t.Fatalf("NormalizeScoreWithWeights failed: %v", status)
+	}
+
+	for _, s := range scores {
+		if s.Score < 0 || s.Score > framework.MaxNodeScore {
+			t.Errorf("node %q scored %d, want within [0, %d]", s.Name, s.Score, framework.MaxNodeScore)
+		}
+	}
+}
+
+func TestScoreWithModelConcurrentAcrossNodes(t *testing.T) {
+	pod := newPod("pod", "catA")
+	model := occupantVectorModel{byOccupant: map[string]ObjectiveVector{}}
+
+	const numNodes = 64
+	nodes := make([]string, numNodes)
+	for i := range nodes {
+		nodes[i] = fmt.Sprintf("node%d", i)
+	}
+
+	state := framework.NewCycleState()
+	var wg sync.WaitGroup
+	wg.Add(numNodes)
+	for _, node := range nodes {
+		node := node
+		go func() {
+			defer wg.Done()
+			if _, status := ScoreWithModel(context.Background(), model, state, pod, node, nil); !status.IsSuccess() {
+				t.Errorf("ScoreWithModel(%q) failed: %v", node, status)
+			}
+		}()
+	}
+	wg.Wait()
+
+	slowdowns, err := SlowdownsFromState(state)
+	if err != nil {
+		t.Fatalf("SlowdownsFromState failed: %v", err)
+	}
+	if len(slowdowns) != numNodes {
+		t.Fatalf("SlowdownsFromState returned %d nodes, want %d (concurrent Score calls dropped some)", len(slowdowns), numNodes)
+	}
+}
+
+func TestMinMaxNormalize(t *testing.T) {
+	got := minMaxNormalize([]float64{1, 2, 3})
+	want := []float64{0, 0.5, 1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("minMaxNormalize()[%d] = %f, want %f", i, got[i], want[i])
+		}
+	}
+
+	if got := minMaxNormalize([]float64{5, 5, 5}); got[0] != 0 || got[1] != 0 || got[2] != 0 {
+		t.Errorf("minMaxNormalize(equal values) = %v, want all zeros", got)
+	}
+}
@@ -0,0 +1,57 @@
+// Command acti-webhook runs the ValidatingAdmissionWebhook server for
+// SlowdownProfile CREATE/UPDATE, backed by acti/crd.Webhook.
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/ckatsak/actischedulertest01/acti"
+	"github.com/ckatsak/actischedulertest01/acti/crd"
+)
+
+func main() {
+	bindAddress := flag.String("bind-address", ":8443", "bind address (host:port) for the webhook HTTPS server")
+	tlsCertFile := flag.String("tls-cert-file", "", "path to the webhook server's TLS certificate")
+	tlsKeyFile := flag.String("tls-key-file", "", "path to the webhook server's TLS private key")
+	labelKey := flag.String("acti-label-key", acti.LabelKey, "label key ActiPlugin uses to track its applications")
+	flag.Parse()
+
+	if *tlsCertFile == "" || *tlsKeyFile == "" {
+		klog.Fatal("acti-webhook: --tls-cert-file and --tls-key-file are required")
+	}
+
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		klog.Fatalf("acti-webhook: failed to build in-cluster config: %v", err)
+	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("acti-webhook: failed to build clientset: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	factory := informers.NewSharedInformerFactory(client, 0)
+	podLister := factory.Core().V1().Pods().Lister()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	mux := http.NewServeMux()
+	mux.Handle("/validate-slowdownprofile", crd.NewWebhook(*labelKey, podLister))
+
+	klog.InfoS("acti-webhook: serving", "address", *bindAddress)
+	if err := http.ListenAndServeTLS(*bindAddress, *tlsCertFile, *tlsKeyFile, mux); err != nil {
+		klog.ErrorS(err, "acti-webhook: server exited")
+	}
+}
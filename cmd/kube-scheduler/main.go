@@ -1,17 +1,202 @@
 package main
 
 import (
-	"github.com/ckatsak/actischedulertest01/acti"
+	"context"
+	"net/http"
+	"os"
+	"time"
 
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/metrics/legacyregistry"
 	"k8s.io/klog/v2"
 	sched "k8s.io/kubernetes/cmd/kube-scheduler/app"
+
+	"github.com/ckatsak/actischedulertest01/acti"
+	activ1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/acti/v1alpha1"
+	configv1alpha1 "github.com/ckatsak/actischedulertest01/acti/apis/config/v1alpha1"
+	"github.com/ckatsak/actischedulertest01/acti/descheduler"
+	"github.com/ckatsak/actischedulertest01/acti/metrics"
 )
 
+// defaultActiLabelKey must match the label key ActiPlugin itself is
+// configured with; it is also surfaced as a flag here so the descheduler
+// loop can be pointed at a differently-configured plugin if needed.
+const defaultActiLabelKey = "acti.ckatsak.io/category"
+
 func main() {
 	cmd := sched.NewSchedulerCommand(
 		sched.WithPlugin(acti.Name, acti.New),
 	)
+
+	var (
+		kubeconfig         string
+		labelKey           string
+		dryRun             bool
+		maxEvictPerMn      int32
+		metricsBindAddress string
+		modelType          string
+		pmuAlpha           float64
+		pmuBeta            float64
+		pmuNodeMBWCapMBps  float64
+	)
+	cmd.Flags().StringVar(&kubeconfig, "acti-kubeconfig", "", "kubeconfig used by the acti descheduler loop; empty means in-cluster config")
+	cmd.Flags().StringVar(&labelKey, "acti-label-key", defaultActiLabelKey, "label key ActiPlugin uses to track its applications")
+	cmd.Flags().BoolVar(&dryRun, "acti-descheduler-dry-run", false, "compute but never execute acti descheduler evictions")
+	cmd.Flags().Int32Var(&maxEvictPerMn, "acti-descheduler-max-evictions-per-minute", 0, "cluster-wide eviction rate cap; 0 disables the acti descheduler loop")
+	cmd.Flags().StringVar(&metricsBindAddress, "acti-metrics-bind-address", "", "bind address (host:port) for a dedicated ActiPlugin /metrics endpoint; empty disables it")
+	cmd.Flags().StringVar(&modelType, "acti-model-type", string(configv1alpha1.ModelTypeHardcoded), "acti.InterferenceModel the descheduler loop uses: Hardcoded, PMU or CRD")
+	cmd.Flags().Float64Var(&pmuAlpha, "acti-pmu-alpha", 0, "Coefficients.Alpha used when --acti-model-type=PMU")
+	cmd.Flags().Float64Var(&pmuBeta, "acti-pmu-beta", 0, "Coefficients.Beta used when --acti-model-type=PMU")
+	cmd.Flags().Float64Var(&pmuNodeMBWCapMBps, "acti-pmu-node-mbw-cap-mbps", 0, "Coefficients.NodeMBWCapMBps used when --acti-model-type=PMU")
+
+	metrics.Register()
+
+	// cmd.Flags() are only parsed once cmd.Execute() runs below, so the
+	// background services that read them can't simply be started here;
+	// chain onto cmd's PreRunE (run synchronously by cobra right after
+	// flag parsing, before RunE) instead of racing flag parsing with a
+	// fixed sleep.
+	startBackgroundServices := func(*cobra.Command, []string) error {
+		go runDescheduler(deschedulerFlags{
+			kubeconfig:        &kubeconfig,
+			labelKey:          &labelKey,
+			dryRun:            &dryRun,
+			maxEvictPerMn:     &maxEvictPerMn,
+			modelType:         &modelType,
+			pmuAlpha:          &pmuAlpha,
+			pmuBeta:           &pmuBeta,
+			pmuNodeMBWCapMBps: &pmuNodeMBWCapMBps,
+		})
+		go runMetricsServer(&metricsBindAddress)
+		return nil
+	}
+	switch existing := cmd.PreRunE; {
+	case existing != nil:
+		cmd.PreRunE = func(c *cobra.Command, args []string) error {
+			if err := existing(c, args); err != nil {
+				return err
+			}
+			return startBackgroundServices(c, args)
+		}
+	case cmd.PreRun != nil:
+		existingPreRun := cmd.PreRun
+		cmd.PreRun = nil
+		cmd.PreRunE = func(c *cobra.Command, args []string) error {
+			existingPreRun(c, args)
+			return startBackgroundServices(c, args)
+		}
+	default:
+		cmd.PreRunE = startBackgroundServices
+	}
+
 	if err := cmd.Execute(); err != nil {
 		klog.Fatalf("failed to execute %q: %v", acti.Name, err)
 	}
 }
+
+// runMetricsServer serves the ActiPlugin Prometheus collectors registered by
+// metrics.Register on their own bind address, in addition to kube-scheduler's
+// own /metrics (which already carries them via legacyregistry).
+func runMetricsServer(bindAddress *string) {
+	if *bindAddress == "" {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", legacyregistry.Handler())
+	klog.InfoS("acti metrics: serving", "address", *bindAddress)
+	if err := http.ListenAndServe(*bindAddress, mux); err != nil {
+		klog.ErrorS(err, "acti metrics: server exited")
+	}
+}
+
+// deschedulerFlags collects the acti descheduler loop's flag values, read
+// only once cobra has parsed them (see runDescheduler).
+type deschedulerFlags struct {
+	kubeconfig        *string
+	labelKey          *string
+	dryRun            *bool
+	maxEvictPerMn     *int32
+	modelType         *string
+	pmuAlpha          *float64
+	pmuBeta           *float64
+	pmuNodeMBWCapMBps *float64
+}
+
+// runDescheduler builds its own clientset and starts the acti descheduler
+// loop under leader election, independently of the scheduler's own startup.
+// Callers must only invoke it once cmd.Flags() have been parsed (see main's
+// PreRunE wiring), since flags is a set of pointers into cobra's flag
+// values.
+func runDescheduler(flags deschedulerFlags) {
+	if *flags.maxEvictPerMn <= 0 {
+		return
+	}
+
+	restCfg, err := clientcmd.BuildConfigFromFlags("", *flags.kubeconfig)
+	if err != nil {
+		restCfg, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		klog.ErrorS(err, "acti descheduler: failed to build client config, not starting")
+		return
+	}
+	client, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		klog.ErrorS(err, "acti descheduler: failed to build clientset, not starting")
+		return
+	}
+
+	ctx := context.Background()
+	factory := informers.NewSharedInformerFactory(client, 0)
+	nodeLister := factory.Core().V1().Nodes().Lister()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	cfg := configv1alpha1.ActiConfig{
+		MaxEvictionsPerMinute: *flags.maxEvictPerMn,
+		DryRun:                *flags.dryRun,
+		ModelType:             configv1alpha1.ModelType(*flags.modelType),
+	}
+	var crdClient activ1alpha1.Interface
+	switch cfg.ModelType {
+	case configv1alpha1.ModelTypePMU:
+		cfg.PMU = &configv1alpha1.PMUModelConfig{
+			Alpha:          *flags.pmuAlpha,
+			Beta:           *flags.pmuBeta,
+			NodeMBWCapMBps: *flags.pmuNodeMBWCapMBps,
+		}
+	case configv1alpha1.ModelTypeCRD:
+		c, err := activ1alpha1.NewForConfig(restCfg)
+		if err != nil {
+			klog.ErrorS(err, "acti descheduler: failed to build SlowdownProfile client, not starting")
+			return
+		}
+		crdClient = c
+	}
+	model, err := acti.BuildModel(ctx, cfg, *flags.labelKey, nodeLister, crdClient)
+	if err != nil {
+		klog.ErrorS(err, "acti descheduler: failed to build model, not starting")
+		return
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: client.CoreV1().Events("")})
+	recorder := broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "acti-descheduler"})
+
+	controller := descheduler.New(client, recorder, *flags.labelKey, model, cfg, 30*time.Second)
+
+	identity, _ := os.Hostname()
+	namespace := os.Getenv("POD_NAMESPACE")
+	if namespace == "" {
+		namespace = "kube-system"
+	}
+	controller.RunOrDie(ctx, client, identity, namespace)
+}
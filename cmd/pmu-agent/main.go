@@ -0,0 +1,47 @@
+//go:build linux
+
+// Command pmu-agent is the per-node DaemonSet agent that samples hardware
+// performance counters for every Pod on the node and publishes the resulting
+// pmu.ContentionVector on the Pod's annotations, for the scheduler's
+// acti/pmu.Model to consume.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/ckatsak/actischedulertest01/acti/pmu/agent"
+)
+
+func main() {
+	nodeName := flag.String("node-name", os.Getenv("NODE_NAME"), "name of the node this agent is running on")
+	window := flag.Duration("sample-window", 10*time.Second, "rolling window over which counters are sampled")
+	flag.Parse()
+
+	if *nodeName == "" {
+		klog.Fatal("pmu-agent: --node-name (or $NODE_NAME) must be set")
+	}
+
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		klog.Fatalf("pmu-agent: failed to build in-cluster config: %v", err)
+	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("pmu-agent: failed to build clientset: %v", err)
+	}
+
+	sampler := agent.NewSampler(client, *nodeName, *window, agent.ListLocalPods(client, *nodeName))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	sampler.Run(ctx)
+}